@@ -0,0 +1,171 @@
+package skytap
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/skytap/skytap-sdk-go/skytap"
+)
+
+const (
+	apiMediaType     = "application/json"
+	apiRetryAfter    = 10
+	apiTimeout       = 2 * time.Minute
+	headerRequestID  = "X-Request-ID"
+	headerRetryAfter = "Retry-After"
+)
+
+// apiClient issues requests against the Skytap API endpoints which are not covered by the Skytap SDK.
+// It shares the base URL, user agent and credentials of the SDK client, and reports failures as
+// *skytap.ErrorResponse so that the existing error helpers keep working.
+type apiClient struct {
+	hc          *http.Client
+	baseURL     *url.URL
+	userAgent   string
+	credentials skytap.CredentialsProvider
+	retryAfter  int
+}
+
+func newAPIClient(client *skytap.Client) *apiClient {
+	return &apiClient{
+		hc:          &http.Client{Timeout: apiTimeout},
+		baseURL:     client.BaseURL,
+		userAgent:   client.UserAgent,
+		credentials: client.Credentials,
+		retryAfter:  apiRetryAfter,
+	}
+}
+
+// request builds and sends a request, decoding the JSON response into v when v is not nil
+func (c *apiClient) request(ctx context.Context, method string, path string, body interface{}, v interface{}) error {
+	req, err := c.newRequest(ctx, method, path, body)
+	if err != nil {
+		return err
+	}
+	return c.do(ctx, req, v)
+}
+
+func (c *apiClient) newRequest(ctx context.Context, method string, path string, body interface{}) (*http.Request, error) {
+	rel, err := url.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+	u := c.baseURL.ResolveReference(rel)
+
+	var buf io.Reader
+	if body != nil {
+		b := new(bytes.Buffer)
+		if err := json.NewEncoder(b).Encode(body); err != nil {
+			return nil, err
+		}
+		buf = b
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", apiMediaType)
+	}
+	req.Header.Set("Accept", apiMediaType)
+	req.Header.Set("User-Agent", c.userAgent)
+
+	auth, err := c.credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if auth != "" {
+		req.Header.Set("Authorization", auth)
+	}
+	return req, nil
+}
+
+// do sends the request. Requests rejected because the target is busy, locked or rate limited are
+// retried after the delay advised by the API until the context is done.
+func (c *apiClient) do(ctx context.Context, req *http.Request, v interface{}) error {
+	for {
+		log.Printf("[DEBUG] API request (%s), URL (%s)", req.Method, req.URL.String())
+		resp, err := c.hc.Do(req)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+			defer resp.Body.Close()
+			if v == nil || resp.StatusCode == http.StatusNoContent {
+				return nil
+			}
+			if err := json.NewDecoder(resp.Body).Decode(v); err != nil && err != io.EOF {
+				return fmt.Errorf("error decoding response from %s %s: %v", req.Method, req.URL, err)
+			}
+			return nil
+		}
+
+		errorResponse := c.buildErrorResponse(resp)
+		if !c.isRetryable(resp.StatusCode, errorResponse) || req.Method == http.MethodGet {
+			return errorResponse
+		}
+
+		log.Printf("[INFO] API request (%s %s) returned %d. Retrying after %d second(s)",
+			req.Method, req.URL.String(), resp.StatusCode, *errorResponse.RetryAfter)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(*errorResponse.RetryAfter) * time.Second):
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return err
+			}
+			req.Body = body
+		}
+	}
+}
+
+func (c *apiClient) isRetryable(code int, errorResponse *skytap.ErrorResponse) bool {
+	switch code {
+	case http.StatusConflict, http.StatusLocked, http.StatusTooManyRequests:
+		return true
+	case http.StatusUnprocessableEntity:
+		return errorResponse.Message != nil && strings.Contains(*errorResponse.Message, "busy")
+	}
+	return false
+}
+
+func (c *apiClient) buildErrorResponse(resp *http.Response) *skytap.ErrorResponse {
+	errorResponse := &skytap.ErrorResponse{Response: resp}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err == nil && len(data) > 0 {
+		message := string(data)
+		errorResponse.Message = &message
+		log.Printf("[INFO] API response error: (%s)", message)
+	}
+	if requestID := resp.Header.Get(headerRequestID); requestID != "" {
+		errorResponse.RequestID = &requestID
+	}
+
+	retryAfter := c.retryAfter
+	if v := resp.Header.Get(headerRetryAfter); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil {
+			retryAfter = seconds
+		}
+	}
+	errorResponse.RetryAfter = &retryAfter
+
+	return errorResponse
+}
@@ -0,0 +1,90 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/skytap/skytap-sdk-go/skytap"
+)
+
+// EnvironmentManagementService is the contract for the environment operations which are not provided by the SDK
+// skytap.EnvironmentsService.
+type EnvironmentManagementService interface {
+	UpdateSequencing(ctx context.Context, id string, opts *UpdateSequencingRequest) (*skytap.Environment, error)
+	Create(ctx context.Context, opts *CreateEnvironmentRequest) (*skytap.Environment, error)
+	Copy(ctx context.Context, opts *CopyEnvironmentRequest) (*skytap.Environment, error)
+	Merge(ctx context.Context, id string, opts *MergeEnvironmentRequest) (*skytap.Environment, error)
+}
+
+// EnvironmentManagementServiceClient is the EnvironmentManagementService implementation
+type EnvironmentManagementServiceClient struct {
+	client *apiClient
+}
+
+// UpdateSequencingRequest describes the startup sequence of the environment VMs. The stages are left unchanged when
+// Stages is nil and are removed when it points to an empty list.
+type UpdateSequencingRequest struct {
+	SequencingEnabled *bool           `json:"sequencing_enabled,omitempty"`
+	Stages            *[]skytap.Stage `json:"stages,omitempty"`
+}
+
+// CreateEnvironmentRequest describes the template an environment is created from
+type CreateEnvironmentRequest struct {
+	TemplateID *string `json:"template_id"`
+}
+
+// CopyEnvironmentRequest describes the environment to copy
+type CopyEnvironmentRequest struct {
+	EnvironmentID *string `json:"configuration_id"`
+}
+
+// MergeEnvironmentRequest describes the template or environment merged into an environment. VMIDs restricts the
+// merge to some of the source VMs.
+type MergeEnvironmentRequest struct {
+	TemplateID    *string  `json:"template_id,omitempty"`
+	EnvironmentID *string  `json:"merge_configuration,omitempty"`
+	VMIDs         []string `json:"vm_ids,omitempty"`
+}
+
+func environmentPath(id string) string {
+	return fmt.Sprintf("/v2%s/%s.json", configurationsBasePath, id)
+}
+
+// UpdateSequencing updates the VM startup sequence of the environment
+func (s *EnvironmentManagementServiceClient) UpdateSequencing(ctx context.Context, id string, opts *UpdateSequencingRequest) (*skytap.Environment, error) {
+	var environment skytap.Environment
+	if err := s.client.request(ctx, http.MethodPut, environmentPath(id), opts, &environment); err != nil {
+		return nil, err
+	}
+	return &environment, nil
+}
+
+// Create an environment from a template. Unlike the SDK, the environment is not started once its VMs are created.
+func (s *EnvironmentManagementServiceClient) Create(ctx context.Context, opts *CreateEnvironmentRequest) (*skytap.Environment, error) {
+	var environment skytap.Environment
+	if err := s.client.request(ctx, http.MethodPost, configurationsBasePath+".json", opts, &environment); err != nil {
+		return nil, err
+	}
+	return &environment, nil
+}
+
+// Copy an environment. The copy is busy until all the VMs of the source environment are copied.
+func (s *EnvironmentManagementServiceClient) Copy(ctx context.Context, opts *CopyEnvironmentRequest) (*skytap.Environment, error) {
+	var environment skytap.Environment
+	if err := s.client.request(ctx, http.MethodPost, configurationsBasePath+".json", opts, &environment); err != nil {
+		return nil, err
+	}
+	return &environment, nil
+}
+
+// Merge the VMs and networks of a template or of another environment into an environment
+func (s *EnvironmentManagementServiceClient) Merge(ctx context.Context, id string, opts *MergeEnvironmentRequest) (*skytap.Environment, error) {
+	path := fmt.Sprintf("%s/%s.json", configurationsBasePath, id)
+
+	var environment skytap.Environment
+	if err := s.client.request(ctx, http.MethodPut, path, opts, &environment); err != nil {
+		return nil, err
+	}
+	return &environment, nil
+}
@@ -0,0 +1,148 @@
+package skytap
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/skytap/skytap-sdk-go/skytap"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func TestEnvironmentManagementUpdateSequencing(t *testing.T) {
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodPut, req.Method)
+		assert.Equal(t, "/v2/configurations/123.json", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{
+			"sequencing_enabled": true,
+			"stages": [
+				{"index": 0, "delay_after_finish_seconds": 60, "vm_ids": ["1"]},
+				{"index": 1, "delay_after_finish_seconds": 0, "vm_ids": ["2", "3"]}
+			]
+		}`, string(body))
+
+		_, err = rw.Write([]byte(`{"id": "123", "sequencing_enabled": true}`))
+		assert.NoError(t, err)
+	})
+	defer teardown()
+
+	stages := []skytap.Stage{
+		{Index: utils.Int(0), DelayAfterFinishSeconds: utils.Int(60), VMIDs: []string{"1"}},
+		{Index: utils.Int(1), DelayAfterFinishSeconds: utils.Int(0), VMIDs: []string{"2", "3"}},
+	}
+	opts := UpdateSequencingRequest{
+		SequencingEnabled: utils.Bool(true),
+		Stages:            &stages,
+	}
+
+	service := EnvironmentManagementServiceClient{client}
+	environment, err := service.UpdateSequencing(context.Background(), "123", &opts)
+	assert.NoError(t, err)
+	assert.True(t, *environment.SequencingEnabled)
+}
+
+func TestEnvironmentManagementClearSequencing(t *testing.T) {
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"stages": []}`, string(body))
+
+		_, err = rw.Write([]byte(`{"id": "123", "stages": []}`))
+		assert.NoError(t, err)
+	})
+	defer teardown()
+
+	stages := make([]skytap.Stage, 0)
+	service := EnvironmentManagementServiceClient{client}
+	_, err := service.UpdateSequencing(context.Background(), "123", &UpdateSequencingRequest{Stages: &stages})
+	assert.NoError(t, err)
+}
+
+func TestEnvironmentManagementCreate(t *testing.T) {
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, "/configurations.json", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"template_id": "789"}`, string(body))
+
+		_, err = rw.Write([]byte(`{"id": "456", "runstate": "busy"}`))
+		assert.NoError(t, err)
+	})
+	defer teardown()
+
+	service := EnvironmentManagementServiceClient{client}
+	environment, err := service.Create(context.Background(), &CreateEnvironmentRequest{TemplateID: utils.String("789")})
+	assert.NoError(t, err)
+	assert.Equal(t, "456", *environment.ID)
+}
+
+func TestEnvironmentManagementCopy(t *testing.T) {
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, "/configurations.json", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"configuration_id": "123"}`, string(body))
+
+		_, err = rw.Write([]byte(`{"id": "456", "runstate": "busy"}`))
+		assert.NoError(t, err)
+	})
+	defer teardown()
+
+	service := EnvironmentManagementServiceClient{client}
+	environment, err := service.Copy(context.Background(), &CopyEnvironmentRequest{EnvironmentID: utils.String("123")})
+	assert.NoError(t, err)
+	assert.Equal(t, "456", *environment.ID)
+}
+
+func TestEnvironmentManagementMerge(t *testing.T) {
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodPut, req.Method)
+		assert.Equal(t, "/configurations/123.json", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"template_id": "789"}`, string(body))
+
+		_, err = rw.Write([]byte(`{"id": "123", "runstate": "busy"}`))
+		assert.NoError(t, err)
+	})
+	defer teardown()
+
+	service := EnvironmentManagementServiceClient{client}
+	environment, err := service.Merge(context.Background(), "123", &MergeEnvironmentRequest{TemplateID: utils.String("789")})
+	assert.NoError(t, err)
+	assert.Equal(t, "123", *environment.ID)
+}
+
+func TestEnvironmentManagementMergeEnvironmentVM(t *testing.T) {
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodPut, req.Method)
+		assert.Equal(t, "/configurations/123.json", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"merge_configuration": "456", "vm_ids": ["789"]}`, string(body))
+
+		_, err = rw.Write([]byte(`{"id": "123", "runstate": "busy"}`))
+		assert.NoError(t, err)
+	})
+	defer teardown()
+
+	service := EnvironmentManagementServiceClient{client}
+	environment, err := service.Merge(context.Background(), "123", &MergeEnvironmentRequest{
+		EnvironmentID: utils.String("456"),
+		VMIDs:         []string{"789"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "123", *environment.ID)
+}
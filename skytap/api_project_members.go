@@ -0,0 +1,104 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/skytap/skytap-sdk-go/skytap"
+)
+
+// Default URL paths
+const (
+	projectsBasePath  = "/projects"
+	projectUsersPath  = "users"
+	projectGroupsPath = "groups"
+)
+
+// ProjectMembersService is the contract for managing the users and groups of a project
+type ProjectMembersService interface {
+	ListUsers(ctx context.Context, projectID int) (*ProjectMemberListResult, error)
+	AddUser(ctx context.Context, projectID int, userID string, role skytap.ProjectRole) error
+	UpdateUser(ctx context.Context, projectID int, userID string, role skytap.ProjectRole) error
+	RemoveUser(ctx context.Context, projectID int, userID string) error
+	ListGroups(ctx context.Context, projectID int) (*ProjectMemberListResult, error)
+	AddGroup(ctx context.Context, projectID int, groupID string, role skytap.ProjectRole) error
+	UpdateGroup(ctx context.Context, projectID int, groupID string, role skytap.ProjectRole) error
+	RemoveGroup(ctx context.Context, projectID int, groupID string) error
+}
+
+// ProjectMembersServiceClient is the ProjectMembersService implementation
+type ProjectMembersServiceClient struct {
+	client *apiClient
+}
+
+// ProjectMember describes a user or a group and its role within a project
+type ProjectMember struct {
+	ID   *string             `json:"id"`
+	Name *string             `json:"name"`
+	Role *skytap.ProjectRole `json:"role"`
+}
+
+// ProjectMemberListResult is the listing request specific struct
+type ProjectMemberListResult struct {
+	Value []ProjectMember
+}
+
+func projectMemberPath(projectID int, kind string, memberID string, role *skytap.ProjectRole) string {
+	path := fmt.Sprintf("%s/%d/%s/%s.json", projectsBasePath, projectID, kind, url.PathEscape(memberID))
+	if role != nil {
+		path += "?role=" + url.QueryEscape(string(*role))
+	}
+	return path
+}
+
+func (s *ProjectMembersServiceClient) list(ctx context.Context, projectID int, kind string) (*ProjectMemberListResult, error) {
+	path := fmt.Sprintf("%s/%d/%s.json", projectsBasePath, projectID, kind)
+
+	var result ProjectMemberListResult
+	if err := s.client.request(ctx, http.MethodGet, path, nil, &result.Value); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListUsers lists the users of a project
+func (s *ProjectMembersServiceClient) ListUsers(ctx context.Context, projectID int) (*ProjectMemberListResult, error) {
+	return s.list(ctx, projectID, projectUsersPath)
+}
+
+// AddUser adds a user to a project with the given role
+func (s *ProjectMembersServiceClient) AddUser(ctx context.Context, projectID int, userID string, role skytap.ProjectRole) error {
+	return s.client.request(ctx, http.MethodPost, projectMemberPath(projectID, projectUsersPath, userID, &role), nil, nil)
+}
+
+// UpdateUser changes the role of a user within a project
+func (s *ProjectMembersServiceClient) UpdateUser(ctx context.Context, projectID int, userID string, role skytap.ProjectRole) error {
+	return s.client.request(ctx, http.MethodPut, projectMemberPath(projectID, projectUsersPath, userID, &role), nil, nil)
+}
+
+// RemoveUser removes a user from a project
+func (s *ProjectMembersServiceClient) RemoveUser(ctx context.Context, projectID int, userID string) error {
+	return s.client.request(ctx, http.MethodDelete, projectMemberPath(projectID, projectUsersPath, userID, nil), nil, nil)
+}
+
+// ListGroups lists the groups of a project
+func (s *ProjectMembersServiceClient) ListGroups(ctx context.Context, projectID int) (*ProjectMemberListResult, error) {
+	return s.list(ctx, projectID, projectGroupsPath)
+}
+
+// AddGroup adds a group to a project with the given role
+func (s *ProjectMembersServiceClient) AddGroup(ctx context.Context, projectID int, groupID string, role skytap.ProjectRole) error {
+	return s.client.request(ctx, http.MethodPost, projectMemberPath(projectID, projectGroupsPath, groupID, &role), nil, nil)
+}
+
+// UpdateGroup changes the role of a group within a project
+func (s *ProjectMembersServiceClient) UpdateGroup(ctx context.Context, projectID int, groupID string, role skytap.ProjectRole) error {
+	return s.client.request(ctx, http.MethodPut, projectMemberPath(projectID, projectGroupsPath, groupID, &role), nil, nil)
+}
+
+// RemoveGroup removes a group from a project
+func (s *ProjectMembersServiceClient) RemoveGroup(ctx context.Context, projectID int, groupID string) error {
+	return s.client.request(ctx, http.MethodDelete, projectMemberPath(projectID, projectGroupsPath, groupID, nil), nil, nil)
+}
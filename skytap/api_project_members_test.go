@@ -0,0 +1,53 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/skytap/skytap-sdk-go/skytap"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectMembers(t *testing.T) {
+	var requests []string
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		requests = append(requests, fmt.Sprintf("%s %s", req.Method, req.URL.RequestURI()))
+
+		if req.Method == http.MethodGet {
+			_, err := rw.Write([]byte(`[{"id": "1", "name": "jdoe", "role": "editor"}, {"id": "2", "name": "admin", "role": "manager"}]`))
+			assert.NoError(t, err)
+		}
+	})
+	defer teardown()
+
+	service := ProjectMembersServiceClient{client}
+	ctx := context.Background()
+
+	assert.NoError(t, service.AddUser(ctx, 12, "1", skytap.ProjectRoleViewer))
+	assert.NoError(t, service.UpdateUser(ctx, 12, "1", skytap.ProjectRoleEditor))
+	users, err := service.ListUsers(ctx, 12)
+	assert.NoError(t, err)
+	assert.Len(t, users.Value, 2)
+	assert.Equal(t, skytap.ProjectRoleEditor, *findProjectMember(users.Value, "1").Role)
+	assert.Nil(t, findProjectMember(users.Value, "3"))
+	assert.NoError(t, service.RemoveUser(ctx, 12, "1"))
+
+	assert.NoError(t, service.AddGroup(ctx, 12, "5", skytap.ProjectRoleParticipant))
+	assert.NoError(t, service.UpdateGroup(ctx, 12, "5", skytap.ProjectRoleManager))
+	_, err = service.ListGroups(ctx, 12)
+	assert.NoError(t, err)
+	assert.NoError(t, service.RemoveGroup(ctx, 12, "5"))
+
+	assert.Equal(t, []string{
+		"POST /projects/12/users/1.json?role=viewer",
+		"PUT /projects/12/users/1.json?role=editor",
+		"GET /projects/12/users.json",
+		"DELETE /projects/12/users/1.json",
+		"POST /projects/12/groups/5.json?role=participant",
+		"PUT /projects/12/groups/5.json?role=manager",
+		"GET /projects/12/groups.json",
+		"DELETE /projects/12/groups/5.json",
+	}, requests)
+}
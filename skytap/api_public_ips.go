@@ -0,0 +1,66 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Default URL paths
+const (
+	publicIPsBasePath = "/ips"
+)
+
+// PublicIPsService is the contract for listing the account public IP addresses and attaching them to interfaces
+type PublicIPsService interface {
+	List(ctx context.Context) (*PublicIPListResult, error)
+	Attach(ctx context.Context, environmentID string, vmID string, interfaceID string, address string) error
+	Detach(ctx context.Context, environmentID string, vmID string, interfaceID string, address string) error
+}
+
+// PublicIPsServiceClient is the PublicIPsService implementation
+type PublicIPsServiceClient struct {
+	client *apiClient
+}
+
+// PublicIP describes a static public IP address of the account
+type PublicIP struct {
+	ID         *string                  `json:"id"`
+	Address    *string                  `json:"address"`
+	Region     *string                  `json:"region"`
+	DNSName    *string                  `json:"dns_name"`
+	Interfaces []map[string]interface{} `json:"nics"`
+}
+
+// PublicIPListResult is the listing request specific struct
+type PublicIPListResult struct {
+	Value []PublicIP
+}
+
+// AttachPublicIPRequest describes the public IP address attached to an interface
+type AttachPublicIPRequest struct {
+	IP *string `json:"ip"`
+}
+
+// List the public IP addresses of the account
+func (s *PublicIPsServiceClient) List(ctx context.Context) (*PublicIPListResult, error) {
+	var result PublicIPListResult
+	if err := s.client.request(ctx, http.MethodGet, publicIPsBasePath+".json", nil, &result.Value); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Attach a public IP address to an interface
+func (s *PublicIPsServiceClient) Attach(ctx context.Context, environmentID string, vmID string, interfaceID string, address string) error {
+	path := fmt.Sprintf("%s/%s/vms/%s/interfaces/%s/ips.json", configurationsBasePath, environmentID, vmID, interfaceID)
+
+	return s.client.request(ctx, http.MethodPost, path, &AttachPublicIPRequest{IP: &address}, nil)
+}
+
+// Detach a public IP address from an interface
+func (s *PublicIPsServiceClient) Detach(ctx context.Context, environmentID string, vmID string, interfaceID string, address string) error {
+	path := fmt.Sprintf("%s/%s/vms/%s/interfaces/%s/ips/%s.json", configurationsBasePath, environmentID, vmID, interfaceID, address)
+
+	return s.client.request(ctx, http.MethodDelete, path, nil, nil)
+}
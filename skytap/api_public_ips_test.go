@@ -0,0 +1,87 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func TestPublicIPsAttachment(t *testing.T) {
+	var requests []string
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		requests = append(requests, fmt.Sprintf("%s %s %s", req.Method, req.URL.Path, body))
+	})
+	defer teardown()
+
+	service := PublicIPsServiceClient{client}
+	assert.NoError(t, service.Attach(context.Background(), "1", "2", "nic-1", "35.162.10.20"))
+	assert.NoError(t, service.Detach(context.Background(), "1", "2", "nic-1", "35.162.10.20"))
+
+	assert.Equal(t, []string{
+		"POST /configurations/1/vms/2/interfaces/nic-1/ips.json {\"ip\":\"35.162.10.20\"}\n",
+		"DELETE /configurations/1/vms/2/interfaces/nic-1/ips/35.162.10.20.json ",
+	}, requests)
+}
+
+func TestPublicIPsList(t *testing.T) {
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/ips.json", req.URL.Path)
+		_, err := rw.Write([]byte(`[
+			{"id": "35.162.10.20", "address": "35.162.10.20", "region": "US-West", "nics": [{"id": "nic-1"}]},
+			{"id": "35.162.10.21", "address": "35.162.10.21", "region": "US-West", "nics": []},
+			{"id": "52.1.1.1", "address": "52.1.1.1", "region": "US-East", "nics": []}
+		]`))
+		assert.NoError(t, err)
+	})
+	defer teardown()
+
+	service := PublicIPsServiceClient{client}
+	publicIPs, err := service.List(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, publicIPs.Value, 3)
+
+	assert.Len(t, filterDataSourceSkytapPublicIPs(publicIPs.Value, "", "US-West", false, ""), 2)
+	assert.Len(t, filterDataSourceSkytapPublicIPs(publicIPs.Value, "", "", true, ""), 2)
+
+	filtered := filterDataSourceSkytapPublicIPs(publicIPs.Value, "", "US-West", true, "")
+	assert.Len(t, filtered, 1)
+	assert.Equal(t, "35.162.10.21", *filtered[0].Address)
+
+	// the address attached to the interface is still available to it
+	filtered = filterDataSourceSkytapPublicIPs(publicIPs.Value, "", "US-West", true, "nic-1")
+	assert.Len(t, filtered, 2)
+	filtered = filterDataSourceSkytapPublicIPs(publicIPs.Value, "", "US-West", true, "nic-2")
+	assert.Len(t, filtered, 1)
+
+	filtered = filterDataSourceSkytapPublicIPs(publicIPs.Value, "35.162.10.20", "", false, "")
+	assert.Len(t, filtered, 1)
+	assert.Equal(t, "US-West", *filtered[0].Region)
+}
+
+func TestSortPublicIPs(t *testing.T) {
+	publicIPs := []PublicIP{
+		{Address: utils.String("52.1.1.1")},
+		{Address: utils.String("35.162.10.100")},
+		{Address: utils.String("35.162.10.21")},
+	}
+	sortPublicIPs(publicIPs, "")
+
+	assert.Equal(t, "35.162.10.21", *publicIPs[0].Address)
+	assert.Equal(t, "35.162.10.100", *publicIPs[1].Address)
+	assert.Equal(t, "52.1.1.1", *publicIPs[2].Address)
+
+	// the address attached to the interface comes first
+	publicIPs[2].Interfaces = []map[string]interface{}{{"id": "nic-1"}}
+	sortPublicIPs(publicIPs, "nic-1")
+
+	assert.Equal(t, "52.1.1.1", *publicIPs[0].Address)
+	assert.Equal(t, "35.162.10.21", *publicIPs[1].Address)
+}
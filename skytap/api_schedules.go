@@ -0,0 +1,101 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Default URL paths
+const (
+	schedulesBasePath = "/schedules"
+)
+
+// SchedulesService is the contract for the services provided on the Skytap Schedule resource
+type SchedulesService interface {
+	Get(ctx context.Context, id string) (*Schedule, error)
+	Create(ctx context.Context, opts *Schedule) (*Schedule, error)
+	Update(ctx context.Context, id string, opts *Schedule) (*Schedule, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// SchedulesServiceClient is the SchedulesService implementation
+type SchedulesServiceClient struct {
+	client *apiClient
+}
+
+// Schedule describes the recurring actions run on an environment
+type Schedule struct {
+	ID              *string          `json:"id,omitempty"`
+	Title           *string          `json:"title,omitempty"`
+	EnvironmentID   *string          `json:"configuration_id,omitempty"`
+	TimeZone        *string          `json:"time_zone,omitempty"`
+	StartAt         *string          `json:"start_at,omitempty"`
+	EndAt           *string          `json:"end_at"`
+	DeleteAtEnd     *bool            `json:"delete_at_end,omitempty"`
+	RecurringDays   []string         `json:"recurring_days"`
+	Actions         []ScheduleAction `json:"actions"`
+	NextRun         *string          `json:"next_run,omitempty"`
+	LastRun         *string          `json:"last_run,omitempty"`
+	UserID          *string          `json:"user_id,omitempty"`
+	UserDisplayName *string          `json:"user_display_name,omitempty"`
+}
+
+// ScheduleAction is an action run by the schedule, offset in minutes from the start of each scheduled day
+type ScheduleAction struct {
+	ID     *string             `json:"id,omitempty"`
+	Type   *ScheduleActionType `json:"type"`
+	Offset *int                `json:"offset"`
+}
+
+// ScheduleActionType is the type of action run by a schedule
+type ScheduleActionType string
+
+// The schedule action types
+const (
+	ScheduleActionTypeRun      ScheduleActionType = "run"
+	ScheduleActionTypeSuspend  ScheduleActionType = "suspend"
+	ScheduleActionTypeShutdown ScheduleActionType = "shutdown"
+	ScheduleActionTypePowerOff ScheduleActionType = "poweroff"
+)
+
+// The days a schedule can recur on
+var scheduleRecurringDays = []string{"su", "mo", "tu", "we", "th", "fr", "sa"}
+
+// Get a schedule
+func (s *SchedulesServiceClient) Get(ctx context.Context, id string) (*Schedule, error) {
+	path := fmt.Sprintf("%s/%s.json", schedulesBasePath, id)
+
+	var schedule Schedule
+	if err := s.client.request(ctx, http.MethodGet, path, nil, &schedule); err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// Create a schedule
+func (s *SchedulesServiceClient) Create(ctx context.Context, opts *Schedule) (*Schedule, error) {
+	var schedule Schedule
+	if err := s.client.request(ctx, http.MethodPost, schedulesBasePath+".json", opts, &schedule); err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// Update a schedule
+func (s *SchedulesServiceClient) Update(ctx context.Context, id string, opts *Schedule) (*Schedule, error) {
+	path := fmt.Sprintf("%s/%s.json", schedulesBasePath, id)
+
+	var schedule Schedule
+	if err := s.client.request(ctx, http.MethodPut, path, opts, &schedule); err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// Delete a schedule
+func (s *SchedulesServiceClient) Delete(ctx context.Context, id string) error {
+	path := fmt.Sprintf("%s/%s.json", schedulesBasePath, id)
+
+	return s.client.request(ctx, http.MethodDelete, path, nil, nil)
+}
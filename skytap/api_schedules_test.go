@@ -0,0 +1,67 @@
+package skytap
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func TestSchedulesCreate(t *testing.T) {
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, "/schedules.json", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{
+			"title": "office hours",
+			"configuration_id": "123",
+			"start_at": "2022/10/03 08:00:00",
+			"end_at": null,
+			"delete_at_end": false,
+			"recurring_days": ["mo", "fr"],
+			"actions": [{"type": "run", "offset": 0}, {"type": "suspend", "offset": 600}]
+		}`, string(body))
+
+		_, err = rw.Write([]byte(`{
+			"id": "42",
+			"title": "office hours",
+			"configuration_id": "123",
+			"time_zone": "Pacific Time (US & Canada)",
+			"start_at": "2022/10/03 08:00:00",
+			"recurring_days": ["mo", "fr"],
+			"actions": [{"id": "1", "type": "run", "offset": 0}, {"id": "2", "type": "suspend", "offset": 600}]
+		}`))
+		assert.NoError(t, err)
+	})
+	defer teardown()
+
+	run := ScheduleActionTypeRun
+	suspend := ScheduleActionTypeSuspend
+	opts := Schedule{
+		Title:         utils.String("office hours"),
+		EnvironmentID: utils.String("123"),
+		StartAt:       utils.String("2022/10/03 08:00:00"),
+		DeleteAtEnd:   utils.Bool(false),
+		RecurringDays: []string{"mo", "fr"},
+		Actions: []ScheduleAction{
+			{Type: &run, Offset: utils.Int(0)},
+			{Type: &suspend, Offset: utils.Int(600)},
+		},
+	}
+
+	service := SchedulesServiceClient{client}
+	schedule, err := service.Create(context.Background(), &opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "42", *schedule.ID)
+	assert.Equal(t, "Pacific Time (US & Canada)", *schedule.TimeZone)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"type": "run", "offset": 0},
+		map[string]interface{}{"type": "suspend", "offset": 600},
+	}, flattenScheduleActions(schedule.Actions))
+}
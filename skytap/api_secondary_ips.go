@@ -0,0 +1,57 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/skytap/skytap-sdk-go/skytap"
+)
+
+// SecondaryIPsService is the contract for managing the secondary IP addresses of an interface
+type SecondaryIPsService interface {
+	Get(ctx context.Context, environmentID string, vmID string, interfaceID string, id string) (*skytap.SecondaryIP, error)
+	Create(ctx context.Context, environmentID string, vmID string, interfaceID string, opts *CreateSecondaryIPRequest) (*skytap.SecondaryIP, error)
+	Delete(ctx context.Context, environmentID string, vmID string, interfaceID string, id string) error
+}
+
+// SecondaryIPsServiceClient is the SecondaryIPsService implementation
+type SecondaryIPsServiceClient struct {
+	client *apiClient
+}
+
+// CreateSecondaryIPRequest describes the secondary IP address added to an interface
+type CreateSecondaryIPRequest struct {
+	IP *string `json:"ip"`
+}
+
+func secondaryIPsPath(environmentID string, vmID string, interfaceID string) string {
+	return fmt.Sprintf("/v2%s/%s/vms/%s/interfaces/%s/secondary_ips", configurationsBasePath, environmentID, vmID, interfaceID)
+}
+
+// Get a secondary IP address
+func (s *SecondaryIPsServiceClient) Get(ctx context.Context, environmentID string, vmID string, interfaceID string, id string) (*skytap.SecondaryIP, error) {
+	path := fmt.Sprintf("%s/%s", secondaryIPsPath(environmentID, vmID, interfaceID), id)
+
+	var secondaryIP skytap.SecondaryIP
+	if err := s.client.request(ctx, http.MethodGet, path, nil, &secondaryIP); err != nil {
+		return nil, err
+	}
+	return &secondaryIP, nil
+}
+
+// Create a secondary IP address
+func (s *SecondaryIPsServiceClient) Create(ctx context.Context, environmentID string, vmID string, interfaceID string, opts *CreateSecondaryIPRequest) (*skytap.SecondaryIP, error) {
+	var secondaryIP skytap.SecondaryIP
+	if err := s.client.request(ctx, http.MethodPost, secondaryIPsPath(environmentID, vmID, interfaceID), opts, &secondaryIP); err != nil {
+		return nil, err
+	}
+	return &secondaryIP, nil
+}
+
+// Delete a secondary IP address
+func (s *SecondaryIPsServiceClient) Delete(ctx context.Context, environmentID string, vmID string, interfaceID string, id string) error {
+	path := fmt.Sprintf("%s/%s", secondaryIPsPath(environmentID, vmID, interfaceID), id)
+
+	return s.client.request(ctx, http.MethodDelete, path, nil, nil)
+}
@@ -0,0 +1,45 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func TestSecondaryIPs(t *testing.T) {
+	var requests []string
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		requests = append(requests, fmt.Sprintf("%s %s %s", req.Method, req.URL.Path, body))
+
+		if req.Method != http.MethodDelete {
+			_, err = rw.Write([]byte(`{"id": "10.0.3.5-1", "address": "10.0.3.5"}`))
+			assert.NoError(t, err)
+		}
+	})
+	defer teardown()
+
+	service := SecondaryIPsServiceClient{client}
+	secondaryIP, err := service.Create(context.Background(), "1", "2", "nic-1", &CreateSecondaryIPRequest{IP: utils.String("10.0.3.5")})
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.3.5-1", *secondaryIP.ID)
+
+	secondaryIP, err = service.Get(context.Background(), "1", "2", "nic-1", "10.0.3.5-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.3.5", *secondaryIP.Address)
+
+	assert.NoError(t, service.Delete(context.Background(), "1", "2", "nic-1", "10.0.3.5-1"))
+
+	assert.Equal(t, []string{
+		"POST /v2/configurations/1/vms/2/interfaces/nic-1/secondary_ips {\"ip\":\"10.0.3.5\"}\n",
+		"GET /v2/configurations/1/vms/2/interfaces/nic-1/secondary_ips/10.0.3.5-1 ",
+		"DELETE /v2/configurations/1/vms/2/interfaces/nic-1/secondary_ips/10.0.3.5-1 ",
+	}, requests)
+}
@@ -0,0 +1,134 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// Default URL paths
+const (
+	sharingPortalsPath = "publish_sets"
+)
+
+// SharingPortalsService is the contract for managing the sharing portals (publish sets) of an environment
+type SharingPortalsService interface {
+	Get(ctx context.Context, environmentID string, id string) (*SharingPortal, error)
+	Create(ctx context.Context, environmentID string, opts *SharingPortal) (*SharingPortal, error)
+	Update(ctx context.Context, environmentID string, id string, opts *SharingPortal) (*SharingPortal, error)
+	Delete(ctx context.Context, environmentID string, id string) error
+}
+
+// SharingPortalsServiceClient is the SharingPortalsService implementation
+type SharingPortalsServiceClient struct {
+	client *apiClient
+}
+
+// SharingPortal describes a sharing portal giving access to VMs of an environment without a Skytap account
+type SharingPortal struct {
+	ID             *string           `json:"id,omitempty"`
+	Name           *string           `json:"name,omitempty"`
+	PublishSetType *string           `json:"publish_set_type,omitempty"`
+	VMs            []SharingPortalVM `json:"vms"`
+	Password       *string           `json:"password"`
+	StartTime      *string           `json:"start_time"`
+	EndTime        *string           `json:"end_time"`
+	TimeZone       *string           `json:"time_zone,omitempty"`
+	DesktopsURL    *string           `json:"desktops_url,omitempty"`
+}
+
+// SharingPortalVM is a VM included in a sharing portal. The API references the VM by URL in VMRef,
+// VMID is filled in from it by the service client.
+type SharingPortalVM struct {
+	VMID       *string              `json:"-"`
+	VMRef      *string              `json:"vm_ref"`
+	Access     *SharingPortalAccess `json:"access"`
+	DesktopURL *string              `json:"desktop_url,omitempty"`
+}
+
+// SharingPortalAccess is the level of access given to a VM of a sharing portal
+type SharingPortalAccess string
+
+// The sharing portal access levels
+const (
+	SharingPortalAccessRunAndUse      SharingPortalAccess = "run_and_use"
+	SharingPortalAccessUse            SharingPortalAccess = "use"
+	SharingPortalAccessRunAndViewOnly SharingPortalAccess = "run_and_view_only"
+	SharingPortalAccessViewOnly       SharingPortalAccess = "view_only"
+)
+
+// sharingPortalTypeSingleURL publishes all the VMs of the portal behind a single URL
+const sharingPortalTypeSingleURL = "single_url"
+
+func sharingPortalPath(environmentID string, id string) string {
+	if id == "" {
+		return fmt.Sprintf("%s/%s/%s.json", configurationsBasePath, environmentID, sharingPortalsPath)
+	}
+	return fmt.Sprintf("%s/%s/%s/%s.json", configurationsBasePath, environmentID, sharingPortalsPath, id)
+}
+
+// Get a sharing portal
+func (s *SharingPortalsServiceClient) Get(ctx context.Context, environmentID string, id string) (*SharingPortal, error) {
+	var portal SharingPortal
+	if err := s.client.request(ctx, http.MethodGet, sharingPortalPath(environmentID, id), nil, &portal); err != nil {
+		return nil, err
+	}
+	setSharingPortalVMIDs(&portal)
+	return &portal, nil
+}
+
+// Create a sharing portal
+func (s *SharingPortalsServiceClient) Create(ctx context.Context, environmentID string, opts *SharingPortal) (*SharingPortal, error) {
+	s.setVMRefs(opts)
+
+	var portal SharingPortal
+	if err := s.client.request(ctx, http.MethodPost, sharingPortalPath(environmentID, ""), opts, &portal); err != nil {
+		return nil, err
+	}
+	setSharingPortalVMIDs(&portal)
+	return &portal, nil
+}
+
+// Update a sharing portal
+func (s *SharingPortalsServiceClient) Update(ctx context.Context, environmentID string, id string, opts *SharingPortal) (*SharingPortal, error) {
+	s.setVMRefs(opts)
+
+	var portal SharingPortal
+	if err := s.client.request(ctx, http.MethodPut, sharingPortalPath(environmentID, id), opts, &portal); err != nil {
+		return nil, err
+	}
+	setSharingPortalVMIDs(&portal)
+	return &portal, nil
+}
+
+// Delete a sharing portal
+func (s *SharingPortalsServiceClient) Delete(ctx context.Context, environmentID string, id string) error {
+	return s.client.request(ctx, http.MethodDelete, sharingPortalPath(environmentID, id), nil, nil)
+}
+
+func (s *SharingPortalsServiceClient) setVMRefs(portal *SharingPortal) {
+	for i, vm := range portal.VMs {
+		if vm.VMID == nil {
+			continue
+		}
+		ref := s.client.baseURL.ResolveReference(&url.URL{Path: fmt.Sprintf("%s/%s", vmsBasePath, *vm.VMID)}).String()
+		portal.VMs[i].VMRef = &ref
+	}
+}
+
+func setSharingPortalVMIDs(portal *SharingPortal) {
+	for i, vm := range portal.VMs {
+		if vm.VMRef == nil {
+			continue
+		}
+		ref, err := url.Parse(*vm.VMRef)
+		if err != nil {
+			continue
+		}
+		vmID := strings.TrimSuffix(path.Base(ref.Path), ".json")
+		portal.VMs[i].VMID = &vmID
+	}
+}
@@ -0,0 +1,57 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func TestSharingPortals(t *testing.T) {
+	var requests []string
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		requests = append(requests, fmt.Sprintf("%s %s %s", req.Method, req.URL.Path, body))
+
+		if req.Method != http.MethodDelete {
+			_, err = rw.Write([]byte(`{"id": "7", "name": "training", "desktops_url": "https://cloud.skytap.com/published/abc",
+				"vms": [{"vm_ref": "https://cloud.skytap.com/vms/2", "access": "run_and_use", "desktop_url": "https://cloud.skytap.com/vms/abc/desktops"}]}`))
+			assert.NoError(t, err)
+		}
+	})
+	defer teardown()
+
+	access := SharingPortalAccessRunAndUse
+	opts := SharingPortal{
+		Name: utils.String("training"),
+		VMs:  []SharingPortalVM{{VMID: utils.String("2"), Access: &access}},
+	}
+
+	service := SharingPortalsServiceClient{client}
+	portal, err := service.Create(context.Background(), "1", &opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "7", *portal.ID)
+	assert.Equal(t, "2", *portal.VMs[0].VMID)
+
+	portal, err = service.Get(context.Background(), "1", "7")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://cloud.skytap.com/published/abc", *portal.DesktopsURL)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"vm_id": "2", "access": "run_and_use", "desktop_url": "https://cloud.skytap.com/vms/abc/desktops"},
+	}, flattenSharingPortalVMs(portal.VMs))
+
+	assert.NoError(t, service.Delete(context.Background(), "1", "7"))
+
+	vmRef := fmt.Sprintf("%s/vms/2", client.baseURL)
+	assert.Equal(t, []string{
+		fmt.Sprintf("POST /configurations/1/publish_sets.json {\"name\":\"training\",\"vms\":[{\"vm_ref\":\"%s\",\"access\":\"run_and_use\"}],\"password\":null,\"start_time\":null,\"end_time\":null}\n", vmRef),
+		"GET /configurations/1/publish_sets/7.json ",
+		"DELETE /configurations/1/publish_sets/7.json ",
+	}, requests)
+}
@@ -0,0 +1,113 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/skytap/skytap-sdk-go/skytap"
+)
+
+// Default URL paths
+const (
+	templateLegacyBasePath = "/templates"
+	templateBasePath       = "/v2/templates"
+)
+
+// TemplateManagementService is the contract for saving environments as templates and managing them.
+// Reading templates is provided by the SDK skytap.TemplatesService.
+type TemplateManagementService interface {
+	Create(ctx context.Context, opts *CreateTemplateRequest) (*skytap.Template, error)
+	Update(ctx context.Context, id string, opts *UpdateTemplateRequest) (*skytap.Template, error)
+	Delete(ctx context.Context, id string) error
+	CreateTags(ctx context.Context, id string, createTagRequest []*skytap.CreateTagRequest) error
+	DeleteTag(ctx context.Context, id string, tagID string) error
+	ListProjects(ctx context.Context, id string) (*skytap.ProjectListResult, error)
+	AddToProject(ctx context.Context, id string, projectID int) error
+	RemoveFromProject(ctx context.Context, id string, projectID int) error
+}
+
+// TemplateManagementServiceClient is the TemplateManagementService implementation
+type TemplateManagementServiceClient struct {
+	client *apiClient
+}
+
+// CreateTemplateRequest describes the environment saved as a template
+type CreateTemplateRequest struct {
+	EnvironmentID *string `json:"configuration_id"`
+}
+
+// UpdateTemplateRequest describes the update the template data. An empty description clears it.
+type UpdateTemplateRequest struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// Create a template from an environment
+func (s *TemplateManagementServiceClient) Create(ctx context.Context, opts *CreateTemplateRequest) (*skytap.Template, error) {
+	var template skytap.Template
+	if err := s.client.request(ctx, http.MethodPost, templateLegacyBasePath+".json", opts, &template); err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+// Update a template
+func (s *TemplateManagementServiceClient) Update(ctx context.Context, id string, opts *UpdateTemplateRequest) (*skytap.Template, error) {
+	path := fmt.Sprintf("%s/%s.json", templateLegacyBasePath, id)
+
+	var template skytap.Template
+	if err := s.client.request(ctx, http.MethodPut, path, opts, &template); err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+// Delete a template
+func (s *TemplateManagementServiceClient) Delete(ctx context.Context, id string) error {
+	path := fmt.Sprintf("%s/%s.json", templateLegacyBasePath, id)
+
+	return s.client.request(ctx, http.MethodDelete, path, nil, nil)
+}
+
+// CreateTags add tags to the template
+func (s *TemplateManagementServiceClient) CreateTags(ctx context.Context, id string, createTagRequest []*skytap.CreateTagRequest) error {
+	if len(createTagRequest) == 0 {
+		return nil
+	}
+	path := fmt.Sprintf("%s/%s/tags.json", templateBasePath, id)
+
+	return s.client.request(ctx, http.MethodPut, path, createTagRequest, nil)
+}
+
+// DeleteTag removes a tag from the template
+func (s *TemplateManagementServiceClient) DeleteTag(ctx context.Context, id string, tagID string) error {
+	path := fmt.Sprintf("%s/%s/tags/%s.json", templateBasePath, id, tagID)
+
+	return s.client.request(ctx, http.MethodDelete, path, nil, nil)
+}
+
+// ListProjects lists the projects the template is shared with
+func (s *TemplateManagementServiceClient) ListProjects(ctx context.Context, id string) (*skytap.ProjectListResult, error) {
+	path := fmt.Sprintf("%s/%s/projects", templateBasePath, id)
+
+	var result skytap.ProjectListResult
+	if err := s.client.request(ctx, http.MethodGet, path, nil, &result.Value); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// AddToProject shares the template with a project
+func (s *TemplateManagementServiceClient) AddToProject(ctx context.Context, id string, projectID int) error {
+	path := fmt.Sprintf("/v2/projects/%d/templates/%s", projectID, id)
+
+	return s.client.request(ctx, http.MethodPost, path, nil, nil)
+}
+
+// RemoveFromProject stops sharing the template with a project
+func (s *TemplateManagementServiceClient) RemoveFromProject(ctx context.Context, id string, projectID int) error {
+	path := fmt.Sprintf("/v2/projects/%d/templates/%s", projectID, id)
+
+	return s.client.request(ctx, http.MethodDelete, path, nil, nil)
+}
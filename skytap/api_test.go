@@ -0,0 +1,185 @@
+package skytap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/skytap/skytap-sdk-go/skytap"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func createAPIClient(t *testing.T, handler http.HandlerFunc) (*apiClient, func()) {
+	server := httptest.NewServer(handler)
+
+	baseURL, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+
+	client := &apiClient{
+		hc:          server.Client(),
+		baseURL:     baseURL,
+		userAgent:   "terraform-provider-skytap/test",
+		credentials: skytap.NewAPITokenCredentials("user", "token"),
+		retryAfter:  0,
+	}
+	return client, server.Close
+}
+
+func TestAPIClientRequest(t *testing.T) {
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, "/templates.json", req.URL.Path)
+		assert.Equal(t, apiMediaType, req.Header.Get("Accept"))
+		assert.Equal(t, apiMediaType, req.Header.Get("Content-Type"))
+		assert.Equal(t, "terraform-provider-skytap/test", req.Header.Get("User-Agent"))
+		assert.NotEmpty(t, req.Header.Get("Authorization"))
+
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"configuration_id": "123"}`, string(body))
+
+		_, err = rw.Write([]byte(`{"id": "456", "busy": true}`))
+		assert.NoError(t, err)
+	})
+	defer teardown()
+
+	service := TemplateManagementServiceClient{client}
+	template, err := service.Create(context.Background(), &CreateTemplateRequest{EnvironmentID: utils.String("123")})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "456", *template.ID)
+	assert.True(t, *template.Busy)
+}
+
+func TestAPIClientRetriesBusyRequests(t *testing.T) {
+	requestCount := 0
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		requestCount++
+
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"name": "golden"}`, string(body))
+
+		switch requestCount {
+		case 1:
+			rw.WriteHeader(http.StatusLocked)
+		case 2:
+			rw.WriteHeader(http.StatusUnprocessableEntity)
+			_, err = rw.Write([]byte(`{"error": "The template is busy"}`))
+			assert.NoError(t, err)
+		default:
+			_, err = rw.Write([]byte(`{"id": "456", "name": "golden"}`))
+			assert.NoError(t, err)
+		}
+	})
+	defer teardown()
+
+	service := TemplateManagementServiceClient{client}
+	template, err := service.Update(context.Background(), "456", &UpdateTemplateRequest{Name: utils.String("golden")})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, requestCount)
+	assert.Equal(t, "golden", *template.Name)
+}
+
+func TestAPIClientErrorResponse(t *testing.T) {
+	requestCount := 0
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		requestCount++
+		rw.Header().Set(headerRequestID, "abc")
+		rw.WriteHeader(http.StatusNotFound)
+		_, err := rw.Write([]byte(`{"error": "not found"}`))
+		assert.NoError(t, err)
+	})
+	defer teardown()
+
+	service := TemplateManagementServiceClient{client}
+	err := service.Delete(context.Background(), "456")
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, requestCount)
+	assert.True(t, utils.ResponseErrorIsNotFound(err))
+
+	errorResponse, ok := err.(*skytap.ErrorResponse)
+	assert.True(t, ok)
+	assert.Equal(t, "abc", *errorResponse.RequestID)
+	assert.Contains(t, *errorResponse.Message, "not found")
+}
+
+func TestAPIClientUnprocessableNotRetried(t *testing.T) {
+	requestCount := 0
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		requestCount++
+		rw.WriteHeader(http.StatusUnprocessableEntity)
+		_, err := rw.Write([]byte(`{"error": "The name is invalid"}`))
+		assert.NoError(t, err)
+	})
+	defer teardown()
+
+	service := TemplateManagementServiceClient{client}
+	_, err := service.Update(context.Background(), "456", &UpdateTemplateRequest{Name: utils.String("golden")})
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, requestCount)
+}
+
+func TestTemplateManagementListProjects(t *testing.T) {
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodGet, req.Method)
+		assert.Equal(t, "/v2/templates/456/projects", req.URL.Path)
+
+		projects := []map[string]interface{}{{"id": "1", "name": "one"}, {"id": "2", "name": "two"}}
+		err := json.NewEncoder(rw).Encode(projects)
+		assert.NoError(t, err)
+	})
+	defer teardown()
+
+	service := TemplateManagementServiceClient{client}
+	projects, err := service.ListProjects(context.Background(), "456")
+
+	assert.NoError(t, err)
+	assert.Len(t, projects.Value, 2)
+	assert.Equal(t, []interface{}{"1", "2"}, flattenProjectIDs(projects.Value))
+}
+
+func TestTemplateManagementProjectSharing(t *testing.T) {
+	var requests []string
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		requests = append(requests, fmt.Sprintf("%s %s", req.Method, req.URL.Path))
+	})
+	defer teardown()
+
+	service := TemplateManagementServiceClient{client}
+	assert.NoError(t, service.AddToProject(context.Background(), "456", 1))
+	assert.NoError(t, service.RemoveFromProject(context.Background(), "456", 1))
+
+	assert.Equal(t, []string{"POST /v2/projects/1/templates/456", "DELETE /v2/projects/1/templates/456"}, requests)
+}
+
+func TestTemplateManagementUpdateClearsDescription(t *testing.T) {
+	var body string
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		bytes, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		body = string(bytes)
+		_, err = rw.Write([]byte(`{"id": "456", "name": "golden", "description": ""}`))
+		assert.NoError(t, err)
+	})
+	defer teardown()
+
+	service := TemplateManagementServiceClient{client}
+	_, err := service.Update(context.Background(), "456", &UpdateTemplateRequest{
+		Name:        utils.String("golden"),
+		Description: utils.String(""),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "{\"name\":\"golden\",\"description\":\"\"}\n", body)
+}
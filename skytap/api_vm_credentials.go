@@ -0,0 +1,62 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/skytap/skytap-sdk-go/skytap"
+)
+
+// VMCredentialsService is the contract for managing the credentials stored on a VM
+type VMCredentialsService interface {
+	Get(ctx context.Context, vmID string, id string) (*skytap.Credential, error)
+	Create(ctx context.Context, vmID string, opts *VMTextRequest) (*skytap.Credential, error)
+	Update(ctx context.Context, vmID string, id string, opts *VMTextRequest) (*skytap.Credential, error)
+	Delete(ctx context.Context, vmID string, id string) error
+}
+
+// VMCredentialsServiceClient is the VMCredentialsService implementation
+type VMCredentialsServiceClient struct {
+	client *apiClient
+}
+
+// Get a VM credential
+func (s *VMCredentialsServiceClient) Get(ctx context.Context, vmID string, id string) (*skytap.Credential, error) {
+	path := fmt.Sprintf("%s/%s/credentials/%s.json", vmsBasePath, vmID, id)
+
+	var credential skytap.Credential
+	if err := s.client.request(ctx, http.MethodGet, path, nil, &credential); err != nil {
+		return nil, err
+	}
+	return &credential, nil
+}
+
+// Create a credential on a VM
+func (s *VMCredentialsServiceClient) Create(ctx context.Context, vmID string, opts *VMTextRequest) (*skytap.Credential, error) {
+	path := fmt.Sprintf("%s/%s/credentials.json", vmsBasePath, vmID)
+
+	var credential skytap.Credential
+	if err := s.client.request(ctx, http.MethodPost, path, opts, &credential); err != nil {
+		return nil, err
+	}
+	return &credential, nil
+}
+
+// Update the text of a VM credential
+func (s *VMCredentialsServiceClient) Update(ctx context.Context, vmID string, id string, opts *VMTextRequest) (*skytap.Credential, error) {
+	path := fmt.Sprintf("%s/%s/credentials/%s.json", vmsBasePath, vmID, id)
+
+	var credential skytap.Credential
+	if err := s.client.request(ctx, http.MethodPut, path, opts, &credential); err != nil {
+		return nil, err
+	}
+	return &credential, nil
+}
+
+// Delete a VM credential
+func (s *VMCredentialsServiceClient) Delete(ctx context.Context, vmID string, id string) error {
+	path := fmt.Sprintf("%s/%s/credentials/%s.json", vmsBasePath, vmID, id)
+
+	return s.client.request(ctx, http.MethodDelete, path, nil, nil)
+}
@@ -0,0 +1,48 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func TestVMCredentials(t *testing.T) {
+	var requests []string
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		requests = append(requests, fmt.Sprintf("%s %s %s", req.Method, req.URL.Path, body))
+
+		if req.Method != http.MethodDelete {
+			_, err = rw.Write([]byte(`{"id": "20", "text": "admin / secret"}`))
+			assert.NoError(t, err)
+		}
+	})
+	defer teardown()
+
+	service := VMCredentialsServiceClient{client}
+	credential, err := service.Create(context.Background(), "2", &VMTextRequest{Text: utils.String("admin / secret")})
+	assert.NoError(t, err)
+	assert.Equal(t, "20", *credential.ID)
+
+	credential, err = service.Get(context.Background(), "2", "20")
+	assert.NoError(t, err)
+	assert.Equal(t, "admin / secret", *credential.Text)
+
+	_, err = service.Update(context.Background(), "2", "20", &VMTextRequest{Text: utils.String("admin / secret")})
+	assert.NoError(t, err)
+	assert.NoError(t, service.Delete(context.Background(), "2", "20"))
+
+	assert.Equal(t, []string{
+		"POST /vms/2/credentials.json {\"text\":\"admin / secret\"}\n",
+		"GET /vms/2/credentials/20.json ",
+		"PUT /vms/2/credentials/20.json {\"text\":\"admin / secret\"}\n",
+		"DELETE /vms/2/credentials/20.json ",
+	}, requests)
+}
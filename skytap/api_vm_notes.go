@@ -0,0 +1,77 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/skytap/skytap-sdk-go/skytap"
+)
+
+// Default URL paths
+const (
+	vmsBasePath = "/vms"
+)
+
+// VMNotesService is the contract for managing the notes of a VM
+type VMNotesService interface {
+	List(ctx context.Context, vmID string) (*VMNoteListResult, error)
+	Create(ctx context.Context, vmID string, opts *VMTextRequest) (*skytap.Note, error)
+	Update(ctx context.Context, vmID string, id string, opts *VMTextRequest) (*skytap.Note, error)
+	Delete(ctx context.Context, vmID string, id string) error
+}
+
+// VMNotesServiceClient is the VMNotesService implementation
+type VMNotesServiceClient struct {
+	client *apiClient
+}
+
+// VMNoteListResult is the listing request specific struct
+type VMNoteListResult struct {
+	Value []skytap.Note
+}
+
+// VMTextRequest describes the text of a VM note or credential
+type VMTextRequest struct {
+	Text *string `json:"text"`
+}
+
+// List the notes of a VM
+func (s *VMNotesServiceClient) List(ctx context.Context, vmID string) (*VMNoteListResult, error) {
+	path := fmt.Sprintf("%s/%s/notes.json", vmsBasePath, vmID)
+
+	var result VMNoteListResult
+	if err := s.client.request(ctx, http.MethodGet, path, nil, &result.Value); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Create a note on a VM
+func (s *VMNotesServiceClient) Create(ctx context.Context, vmID string, opts *VMTextRequest) (*skytap.Note, error) {
+	path := fmt.Sprintf("%s/%s/notes.json", vmsBasePath, vmID)
+
+	var note skytap.Note
+	if err := s.client.request(ctx, http.MethodPost, path, opts, &note); err != nil {
+		return nil, err
+	}
+	return &note, nil
+}
+
+// Update the text of a VM note
+func (s *VMNotesServiceClient) Update(ctx context.Context, vmID string, id string, opts *VMTextRequest) (*skytap.Note, error) {
+	path := fmt.Sprintf("%s/%s/notes/%s.json", vmsBasePath, vmID, id)
+
+	var note skytap.Note
+	if err := s.client.request(ctx, http.MethodPut, path, opts, &note); err != nil {
+		return nil, err
+	}
+	return &note, nil
+}
+
+// Delete a VM note
+func (s *VMNotesServiceClient) Delete(ctx context.Context, vmID string, id string) error {
+	path := fmt.Sprintf("%s/%s/notes/%s.json", vmsBasePath, vmID, id)
+
+	return s.client.request(ctx, http.MethodDelete, path, nil, nil)
+}
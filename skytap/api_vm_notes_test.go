@@ -0,0 +1,52 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func TestVMNotes(t *testing.T) {
+	var requests []string
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		requests = append(requests, fmt.Sprintf("%s %s %s", req.Method, req.URL.Path, body))
+
+		switch req.Method {
+		case http.MethodGet:
+			_, err = rw.Write([]byte(`[{"id": "10", "text": "first"}, {"id": "11", "text": "second"}]`))
+		case http.MethodPost, http.MethodPut:
+			_, err = rw.Write([]byte(`{"id": "11", "text": "second"}`))
+		}
+		assert.NoError(t, err)
+	})
+	defer teardown()
+
+	service := VMNotesServiceClient{client}
+	note, err := service.Create(context.Background(), "2", &VMTextRequest{Text: utils.String("second")})
+	assert.NoError(t, err)
+	assert.Equal(t, "11", *note.ID)
+
+	notes, err := service.List(context.Background(), "2")
+	assert.NoError(t, err)
+	assert.Equal(t, "second", *findVMNote(notes.Value, "11").Text)
+	assert.Nil(t, findVMNote(notes.Value, "12"))
+
+	_, err = service.Update(context.Background(), "2", "11", &VMTextRequest{Text: utils.String("second")})
+	assert.NoError(t, err)
+	assert.NoError(t, service.Delete(context.Background(), "2", "11"))
+
+	assert.Equal(t, []string{
+		"POST /vms/2/notes.json {\"text\":\"second\"}\n",
+		"GET /vms/2/notes.json ",
+		"PUT /vms/2/notes/11.json {\"text\":\"second\"}\n",
+		"DELETE /vms/2/notes/11.json ",
+	}, requests)
+}
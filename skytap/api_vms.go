@@ -0,0 +1,136 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/skytap/skytap-sdk-go/skytap"
+)
+
+// VMManagementService is the contract for the VM operations which are not provided by the SDK skytap.VMsService.
+type VMManagementService interface {
+	UpdateHardware(ctx context.Context, environmentID string, id string, opts *UpdateVMHardwareRequest) (*skytap.VM, error)
+	UpdateRunstate(ctx context.Context, environmentID string, id string, runstate skytap.VMRunstate) (*skytap.VM, error)
+	Rename(ctx context.Context, environmentID string, id string, name string) (*skytap.VM, error)
+	UpdateDisks(ctx context.Context, environmentID string, id string, opts *UpdateVMDisksRequest) (*skytap.VM, error)
+	UpdateInterfaceType(ctx context.Context, environmentID string, vmID string, id string, nicType skytap.NICType) (*skytap.Interface, error)
+}
+
+// VMManagementServiceClient is the VMManagementService implementation
+type VMManagementServiceClient struct {
+	client *apiClient
+}
+
+// UpdateVMHardwareRequest describes the hardware settings of a VM which are not supported by skytap.UpdateHardware,
+// and the hardware version the VM is upgraded to
+type UpdateVMHardwareRequest struct {
+	Hardware        *VMHardwareSettings `json:"hardware,omitempty"`
+	HardwareVersion *int                `json:"hardware_version,omitempty"`
+}
+
+// VMHardwareSettings describes the CPU topology, virtualization and console settings of a VM
+type VMHardwareSettings struct {
+	CpusPerSocket        *int    `json:"cpus_per_socket,omitempty"`
+	NestedVirtualization *bool   `json:"nested_virtualization,omitempty"`
+	TimeSyncEnabled      *bool   `json:"time_sync_enabled,omitempty"`
+	CopyPasteEnabled     *bool   `json:"copy_paste_enabled,omitempty"`
+	VncKeymap            *string `json:"vnc_keymap,omitempty"`
+	RTCStartTime         *string `json:"rtc_start_time,omitempty"`
+	GuestOS              *string `json:"guestOS,omitempty"`
+}
+
+// UpdateVMRunstateRequest describes the runstate requested for a VM
+type UpdateVMRunstateRequest struct {
+	Runstate *skytap.VMRunstate `json:"runstate"`
+}
+
+// RenameVMRequest describes the name of a VM
+type RenameVMRequest struct {
+	Name *string `json:"name"`
+}
+
+// UpdateVMDisksRequest describes the disks added to, resized in or removed from a VM
+type UpdateVMDisksRequest struct {
+	Hardware *VMDisksHardware `json:"hardware"`
+}
+
+// VMDisksHardware is the hardware section of an UpdateVMDisksRequest
+type VMDisksHardware struct {
+	Disks *VMDisksUpdate `json:"disks"`
+}
+
+// VMDisksUpdate lists the new disks and the existing disks keyed by ID. An existing disk without a size is removed.
+type VMDisksUpdate struct {
+	New      []NewVMDisk                    `json:"new,omitempty"`
+	Existing map[string]skytap.ExistingDisk `json:"existing,omitempty"`
+}
+
+// NewVMDisk describes a disk added to a VM, with the type of its controller
+type NewVMDisk struct {
+	Size *int    `json:"size"`
+	Type *string `json:"type,omitempty"`
+}
+
+// UpdateInterfaceTypeRequest describes the type of a network interface
+type UpdateInterfaceTypeRequest struct {
+	NICType *skytap.NICType `json:"nic_type"`
+}
+
+func vmPath(environmentID string, id string) string {
+	return fmt.Sprintf("/v2%s/%s%s/%s.json", configurationsBasePath, environmentID, vmsBasePath, id)
+}
+
+// UpdateHardware updates the hardware settings of a VM. Most settings require the VM to be stopped.
+func (s *VMManagementServiceClient) UpdateHardware(ctx context.Context, environmentID string, id string, opts *UpdateVMHardwareRequest) (*skytap.VM, error) {
+	var vm skytap.VM
+	if err := s.client.request(ctx, http.MethodPut, vmPath(environmentID, id), opts, &vm); err != nil {
+		return nil, err
+	}
+	return &vm, nil
+}
+
+// UpdateRunstate requests a runstate change without waiting for the VM to reach it. Unlike the SDK, it supports the
+// `halted` runstate, which powers the VM off and leaves it `stopped`.
+func (s *VMManagementServiceClient) UpdateRunstate(ctx context.Context, environmentID string, id string, runstate skytap.VMRunstate) (*skytap.VM, error) {
+	opts := UpdateVMRunstateRequest{Runstate: &runstate}
+
+	var vm skytap.VM
+	if err := s.client.request(ctx, http.MethodPut, vmPath(environmentID, id), &opts, &vm); err != nil {
+		return nil, err
+	}
+	return &vm, nil
+}
+
+// Rename renames a VM. Unlike the SDK update, it does not stop the VM.
+func (s *VMManagementServiceClient) Rename(ctx context.Context, environmentID string, id string, name string) (*skytap.VM, error) {
+	opts := RenameVMRequest{Name: &name}
+
+	var vm skytap.VM
+	if err := s.client.request(ctx, http.MethodPut, vmPath(environmentID, id), &opts, &vm); err != nil {
+		return nil, err
+	}
+	return &vm, nil
+}
+
+// UpdateDisks adds, resizes or removes disks of a VM without waiting for the change to complete. The VM must be
+// stopped.
+func (s *VMManagementServiceClient) UpdateDisks(ctx context.Context, environmentID string, id string, opts *UpdateVMDisksRequest) (*skytap.VM, error) {
+	var vm skytap.VM
+	if err := s.client.request(ctx, http.MethodPut, vmPath(environmentID, id), opts, &vm); err != nil {
+		return nil, err
+	}
+	return &vm, nil
+}
+
+// UpdateInterfaceType changes the type of a network interface, keeping its MAC address. The VM must be stopped.
+func (s *VMManagementServiceClient) UpdateInterfaceType(ctx context.Context, environmentID string, vmID string, id string, nicType skytap.NICType) (*skytap.Interface, error) {
+	path := fmt.Sprintf("/v2%s/%s%s/%s/interfaces/%s.json", configurationsBasePath, environmentID, vmsBasePath, vmID, id)
+	opts := UpdateInterfaceTypeRequest{NICType: &nicType}
+
+	var networkInterface skytap.Interface
+	if err := s.client.request(ctx, http.MethodPut, path, &opts, &networkInterface); err != nil {
+		return nil, err
+	}
+	return &networkInterface, nil
+}
@@ -0,0 +1,155 @@
+package skytap
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/skytap/skytap-sdk-go/skytap"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func TestVMManagementUpdateHardware(t *testing.T) {
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodPut, req.Method)
+		assert.Equal(t, "/v2/configurations/123/vms/456.json", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"hardware": {"cpus_per_socket": 2, "nested_virtualization": false, "guestOS": "ubuntu-64"}}`, string(body))
+
+		_, err = rw.Write([]byte(`{"id": "456", "hardware": {"cpus_per_socket": 2, "nested_virtualization": false, "guestOS": "ubuntu-64"}}`))
+		assert.NoError(t, err)
+	})
+	defer teardown()
+
+	service := VMManagementServiceClient{client}
+	vm, err := service.UpdateHardware(context.Background(), "123", "456", &UpdateVMHardwareRequest{
+		Hardware: &VMHardwareSettings{
+			CpusPerSocket:        utils.Int(2),
+			NestedVirtualization: utils.Bool(false),
+			GuestOS:              utils.String("ubuntu-64"),
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *vm.Hardware.CpusPerSocket)
+	assert.Equal(t, "ubuntu-64", *vm.Hardware.GuestOS)
+}
+
+func TestVMManagementUpgradeHardwareVersion(t *testing.T) {
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodPut, req.Method)
+		assert.Equal(t, "/v2/configurations/123/vms/456.json", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"hardware_version": 19}`, string(body))
+
+		_, err = rw.Write([]byte(`{"id": "456", "hardware_version": 19, "max_hardware_version": 19}`))
+		assert.NoError(t, err)
+	})
+	defer teardown()
+
+	service := VMManagementServiceClient{client}
+	vm, err := service.UpdateHardware(context.Background(), "123", "456", &UpdateVMHardwareRequest{HardwareVersion: utils.Int(19)})
+	assert.NoError(t, err)
+	assert.Equal(t, 19, *vm.HardwareVersion)
+}
+
+func TestVMManagementUpdateRunstate(t *testing.T) {
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodPut, req.Method)
+		assert.Equal(t, "/v2/configurations/123/vms/456.json", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"runstate": "halted"}`, string(body))
+
+		_, err = rw.Write([]byte(`{"id": "456", "runstate": "busy"}`))
+		assert.NoError(t, err)
+	})
+	defer teardown()
+
+	service := VMManagementServiceClient{client}
+	vm, err := service.UpdateRunstate(context.Background(), "123", "456", skytap.VMRunstateHalted)
+	assert.NoError(t, err)
+	assert.Equal(t, skytap.VMRunstateBusy, *vm.Runstate)
+}
+
+func TestVMManagementRename(t *testing.T) {
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodPut, req.Method)
+		assert.Equal(t, "/v2/configurations/123/vms/456.json", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"name": "renamed"}`, string(body))
+
+		_, err = rw.Write([]byte(`{"id": "456", "name": "renamed", "runstate": "running"}`))
+		assert.NoError(t, err)
+	})
+	defer teardown()
+
+	service := VMManagementServiceClient{client}
+	vm, err := service.Rename(context.Background(), "123", "456", "renamed")
+	assert.NoError(t, err)
+	assert.Equal(t, "renamed", *vm.Name)
+	assert.Equal(t, skytap.VMRunstateRunning, *vm.Runstate)
+}
+
+func TestVMManagementUpdateDisks(t *testing.T) {
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodPut, req.Method)
+		assert.Equal(t, "/v2/configurations/123/vms/456.json", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"hardware": {"disks": {
+			"new": [{"size": 4096, "type": "SATA"}, {"size": 2048}],
+			"existing": {"disk-1": {"id": "disk-1", "size": 30720}, "disk-2": {"id": "disk-2", "size": null}}
+		}}}`, string(body))
+
+		_, err = rw.Write([]byte(`{"id": "456", "runstate": "busy"}`))
+		assert.NoError(t, err)
+	})
+	defer teardown()
+
+	service := VMManagementServiceClient{client}
+	vm, err := service.UpdateDisks(context.Background(), "123", "456", &UpdateVMDisksRequest{
+		Hardware: &VMDisksHardware{
+			Disks: &VMDisksUpdate{
+				New: []NewVMDisk{{Size: utils.Int(4096), Type: utils.String("SATA")}, {Size: utils.Int(2048)}},
+				Existing: map[string]skytap.ExistingDisk{
+					"disk-1": {ID: utils.String("disk-1"), Size: utils.Int(30720)},
+					"disk-2": {ID: utils.String("disk-2")},
+				},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, skytap.VMRunstateBusy, *vm.Runstate)
+}
+
+func TestVMManagementUpdateInterfaceType(t *testing.T) {
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodPut, req.Method)
+		assert.Equal(t, "/v2/configurations/123/vms/456/interfaces/nic-1.json", req.URL.Path)
+
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"nic_type": "e1000e"}`, string(body))
+
+		_, err = rw.Write([]byte(`{"id": "nic-1", "nic_type": "e1000e", "mac": "00:50:56:11:22:33"}`))
+		assert.NoError(t, err)
+	})
+	defer teardown()
+
+	service := VMManagementServiceClient{client}
+	networkInterface, err := service.UpdateInterfaceType(context.Background(), "123", "456", "nic-1", skytap.NICTypeE1000E)
+	assert.NoError(t, err)
+	assert.Equal(t, skytap.NICTypeE1000E, *networkInterface.NICType)
+	assert.Equal(t, "00:50:56:11:22:33", *networkInterface.MAC)
+}
@@ -0,0 +1,81 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/skytap/skytap-sdk-go/skytap"
+)
+
+// Default URL paths
+const (
+	vpnsBasePath           = "/vpns"
+	configurationsBasePath = "/configurations"
+)
+
+// VPNsService is the contract for listing the account VPNs and attaching networks to them
+type VPNsService interface {
+	List(ctx context.Context) (*VPNListResult, error)
+	Attach(ctx context.Context, environmentID string, networkID string, vpnID string) (*skytap.VPNAttachment, error)
+	Connect(ctx context.Context, environmentID string, networkID string, vpnID string, connected bool) (*skytap.VPNAttachment, error)
+	Detach(ctx context.Context, environmentID string, networkID string, vpnID string) error
+}
+
+// VPNsServiceClient is the VPNsService implementation
+type VPNsServiceClient struct {
+	client *apiClient
+}
+
+// VPNListResult is the listing request specific struct
+type VPNListResult struct {
+	Value []skytap.VPN
+}
+
+// AttachVPNRequest describes the VPN the network is attached to
+type AttachVPNRequest struct {
+	VPNID *string `json:"vpn_id"`
+}
+
+// ConnectVPNRequest describes the connection of an attached network
+type ConnectVPNRequest struct {
+	Connected *bool `json:"connected"`
+}
+
+// List the VPNs and private network connections of the account
+func (s *VPNsServiceClient) List(ctx context.Context) (*VPNListResult, error) {
+	var result VPNListResult
+	if err := s.client.request(ctx, http.MethodGet, vpnsBasePath+".json", nil, &result.Value); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Attach a network to a VPN
+func (s *VPNsServiceClient) Attach(ctx context.Context, environmentID string, networkID string, vpnID string) (*skytap.VPNAttachment, error) {
+	path := fmt.Sprintf("%s/%s/networks/%s/vpns.json", configurationsBasePath, environmentID, networkID)
+
+	var attachment skytap.VPNAttachment
+	if err := s.client.request(ctx, http.MethodPost, path, &AttachVPNRequest{VPNID: &vpnID}, &attachment); err != nil {
+		return nil, err
+	}
+	return &attachment, nil
+}
+
+// Connect or disconnect an attached network
+func (s *VPNsServiceClient) Connect(ctx context.Context, environmentID string, networkID string, vpnID string, connected bool) (*skytap.VPNAttachment, error) {
+	path := fmt.Sprintf("%s/%s/networks/%s/vpns/%s.json", configurationsBasePath, environmentID, networkID, vpnID)
+
+	var attachment skytap.VPNAttachment
+	if err := s.client.request(ctx, http.MethodPut, path, &ConnectVPNRequest{Connected: &connected}, &attachment); err != nil {
+		return nil, err
+	}
+	return &attachment, nil
+}
+
+// Detach a network from a VPN
+func (s *VPNsServiceClient) Detach(ctx context.Context, environmentID string, networkID string, vpnID string) error {
+	path := fmt.Sprintf("%s/%s/networks/%s/vpns/%s.json", configurationsBasePath, environmentID, networkID, vpnID)
+
+	return s.client.request(ctx, http.MethodDelete, path, nil, nil)
+}
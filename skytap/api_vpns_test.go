@@ -0,0 +1,61 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVPNsAttachment(t *testing.T) {
+	var requests []string
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		requests = append(requests, fmt.Sprintf("%s %s %s", req.Method, req.URL.Path, body))
+
+		if req.Method != http.MethodDelete {
+			_, err = rw.Write([]byte(`{"id": "7-9", "connected": true, "vpn": {"id": "9", "name": "site"}}`))
+			assert.NoError(t, err)
+		}
+	})
+	defer teardown()
+
+	service := VPNsServiceClient{client}
+	attachment, err := service.Attach(context.Background(), "1", "7", "9")
+	assert.NoError(t, err)
+	assert.Equal(t, "7-9", *attachment.ID)
+
+	attachment, err = service.Connect(context.Background(), "1", "7", "9", true)
+	assert.NoError(t, err)
+	assert.True(t, *attachment.Connected)
+
+	assert.NoError(t, service.Detach(context.Background(), "1", "7", "9"))
+
+	assert.Equal(t, []string{
+		"POST /configurations/1/networks/7/vpns.json {\"vpn_id\":\"9\"}\n",
+		"PUT /configurations/1/networks/7/vpns/9.json {\"connected\":true}\n",
+		"DELETE /configurations/1/networks/7/vpns/9.json ",
+	}, requests)
+}
+
+func TestVPNsList(t *testing.T) {
+	client, teardown := createAPIClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/vpns.json", req.URL.Path)
+		_, err := rw.Write([]byte(`[{"id": "9", "name": "site", "nat_enabled": true}, {"id": "10", "name": "other"}]`))
+		assert.NoError(t, err)
+	})
+	defer teardown()
+
+	service := VPNsServiceClient{client}
+	vpns, err := service.List(context.Background())
+	assert.NoError(t, err)
+
+	filtered := filterDataSourceSkytapVPNsByName(vpns.Value, "site")
+	assert.Len(t, filtered, 1)
+	assert.Equal(t, "9", *filtered[0].ID)
+	assert.True(t, *filtered[0].NatEnabled)
+}
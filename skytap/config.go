@@ -26,6 +26,21 @@ type SkytapClient struct {
 	publishedServicesClient skytap.PublishedServicesService
 	labelCategoryClient     skytap.LabelCategoryService
 	icnrTunnelClient        skytap.ICNRTunnelService
+
+	templateManagementClient    TemplateManagementService
+	vpnsClient                  VPNsService
+	publicIPsClient             PublicIPsService
+	secondaryIPsClient          SecondaryIPsService
+	schedulesClient             SchedulesService
+	environmentManagementClient EnvironmentManagementService
+	projectMembersClient        ProjectMembersService
+	vmNotesClient               VMNotesService
+	vmCredentialsClient         VMCredentialsService
+	sharingPortalsClient        SharingPortalsService
+	vmManagementClient          VMManagementService
+
+	// environmentLocks serializes the merges into an environment
+	environmentLocks *mutexKV
 }
 
 // Client creates a SkytapClient client
@@ -47,6 +62,20 @@ func (c *Config) Client() (*SkytapClient, error) {
 		icnrTunnelClient:        client.ICNRTunnel,
 	}
 
+	api := newAPIClient(client)
+	skytapClient.templateManagementClient = &TemplateManagementServiceClient{api}
+	skytapClient.vpnsClient = &VPNsServiceClient{api}
+	skytapClient.publicIPsClient = &PublicIPsServiceClient{api}
+	skytapClient.secondaryIPsClient = &SecondaryIPsServiceClient{api}
+	skytapClient.schedulesClient = &SchedulesServiceClient{api}
+	skytapClient.environmentManagementClient = &EnvironmentManagementServiceClient{api}
+	skytapClient.projectMembersClient = &ProjectMembersServiceClient{api}
+	skytapClient.vmNotesClient = &VMNotesServiceClient{api}
+	skytapClient.vmCredentialsClient = &VMCredentialsServiceClient{api}
+	skytapClient.sharingPortalsClient = &SharingPortalsServiceClient{api}
+	skytapClient.vmManagementClient = &VMManagementServiceClient{api}
+	skytapClient.environmentLocks = newMutexKV()
+
 	return &skytapClient, nil
 }
 
@@ -0,0 +1,160 @@
+package skytap
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"net"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceSkytapPublicIP() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSkytapPublicIPRead,
+
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The public IP address",
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The region of the public IP address",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"available": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If set to `true`, only public IP addresses which are not attached to an interface are matched",
+			},
+
+			"interface_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "ID of the network interface the public IP address is picked for. With `available`, the public IP address already attached to this interface is still matched and preferred, so the choice does not change once it is attached",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// computed attributes
+			"dns_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The DNS name of the public IP address",
+			},
+
+			"attached": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the public IP address is attached to an interface",
+			},
+		},
+	}
+}
+
+func dataSourceSkytapPublicIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).publicIPsClient
+
+	log.Printf("[INFO] preparing arguments for finding the Skytap public IP")
+
+	address := d.Get("address").(string)
+	region := d.Get("region").(string)
+	available := d.Get("available").(bool)
+	interfaceID := d.Get("interface_id").(string)
+
+	publicIPsResult, err := client.List(ctx)
+	if err != nil {
+		return diag.Errorf("error retrieving public IPs: %s", err)
+	}
+
+	publicIPs := filterDataSourceSkytapPublicIPs(publicIPsResult.Value, address, region, available, interfaceID)
+
+	if len(publicIPs) == 0 {
+		return diag.Errorf("no public IP found matching the criteria")
+	}
+
+	if len(publicIPs) > 1 && address != "" {
+		return diag.Errorf("too many public IPs found matching the criteria (found %d, expected 1)", len(publicIPs))
+	}
+
+	// several addresses can be free in the pool, so return the one attached to the interface, then the lowest one, to
+	// keep the choice stable
+	sortPublicIPs(publicIPs, interfaceID)
+	publicIP := publicIPs[0]
+	if publicIP.Address == nil {
+		return diag.Errorf("public IP address is not set")
+	}
+	d.SetId(*publicIP.Address)
+
+	err = d.Set("address", publicIP.Address)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("region", publicIP.Region)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("dns_name", publicIP.DNSName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("attached", len(publicIP.Interfaces) > 0)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func filterDataSourceSkytapPublicIPs(publicIPs []PublicIP, address string, region string, available bool, interfaceID string) []PublicIP {
+	var result []PublicIP
+	for _, v := range publicIPs {
+		if address != "" && (v.Address == nil || *v.Address != address) {
+			continue
+		}
+		if region != "" && (v.Region == nil || *v.Region != region) {
+			continue
+		}
+		if available && len(v.Interfaces) > 0 && !publicIPAttachedTo(v, interfaceID) {
+			continue
+		}
+		result = append(result, v)
+	}
+	return result
+}
+
+// publicIPAttachedTo reports whether the public IP address is attached to the interface, and only to it
+func publicIPAttachedTo(publicIP PublicIP, interfaceID string) bool {
+	if interfaceID == "" || len(publicIP.Interfaces) != 1 {
+		return false
+	}
+	id, _ := publicIP.Interfaces[0]["id"].(string)
+	return id == interfaceID
+}
+
+// sortPublicIPs orders the public IP addresses attached to the interface first, then by address
+func sortPublicIPs(publicIPs []PublicIP, interfaceID string) {
+	key := func(v PublicIP) []byte {
+		if v.Address == nil {
+			return nil
+		}
+		return net.ParseIP(*v.Address).To16()
+	}
+	sort.SliceStable(publicIPs, func(i, j int) bool {
+		attachedI, attachedJ := publicIPAttachedTo(publicIPs[i], interfaceID), publicIPAttachedTo(publicIPs[j], interfaceID)
+		if attachedI != attachedJ {
+			return attachedI
+		}
+		return bytes.Compare(key(publicIPs[i]), key(publicIPs[j])) < 0
+	})
+}
@@ -0,0 +1,37 @@
+package skytap
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func TestAccDataSourceSkytapPublicIP_Basic(t *testing.T) {
+	address := utils.GetEnv("SKYTAP_PUBLIC_IP", "35.162.10.20")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSkytapPublicIPConfig_basic(address),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.skytap_public_ip.foo", "id", address),
+					resource.TestCheckResourceAttr("data.skytap_public_ip.foo", "address", address),
+					resource.TestCheckResourceAttrSet("data.skytap_public_ip.foo", "region"),
+					resource.TestCheckResourceAttrSet("data.skytap_public_ip.foo", "attached"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSkytapPublicIPConfig_basic(address string) string {
+	return fmt.Sprintf(`
+data "skytap_public_ip" "foo" {
+	address = %q
+}`, address)
+}
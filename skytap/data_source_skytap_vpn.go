@@ -0,0 +1,113 @@
+package skytap
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/skytap/skytap-sdk-go/skytap"
+)
+
+func dataSourceSkytapVPN() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSkytapVPNRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The name of the VPN or private network connection",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// computed attributes
+			"enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the VPN is enabled",
+			},
+
+			"nat_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether network address translation is enabled on the VPN",
+			},
+
+			"remote_subnets": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The subnets on the remote side of the VPN",
+			},
+
+			"remote_peer_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IP address of the remote VPN peer",
+			},
+		},
+	}
+}
+
+func dataSourceSkytapVPNRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).vpnsClient
+
+	log.Printf("[INFO] preparing arguments for finding the Skytap VPN")
+
+	name := d.Get("name").(string)
+
+	vpnsResult, err := client.List(ctx)
+	if err != nil {
+		return diag.Errorf("error retrieving VPNs: %s", err)
+	}
+
+	vpns := filterDataSourceSkytapVPNsByName(vpnsResult.Value, name)
+
+	if len(vpns) == 0 {
+		return diag.Errorf("no VPN found with name %s", name)
+	}
+
+	if len(vpns) > 1 {
+		return diag.Errorf("too many VPNs found with name %s (found %d, expected 1)", name, len(vpns))
+	}
+
+	vpn := vpns[0]
+	if vpn.ID == nil {
+		return diag.Errorf("VPN ID is not set")
+	}
+	d.SetId(*vpn.ID)
+
+	err = d.Set("name", vpn.Name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("enabled", vpn.Enabled)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("nat_enabled", vpn.NatEnabled)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("remote_subnets", vpn.RemoteSubnets)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("remote_peer_ip", vpn.RemotePeerIP)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func filterDataSourceSkytapVPNsByName(vpns []skytap.VPN, name string) []skytap.VPN {
+	var result []skytap.VPN
+	for _, v := range vpns {
+		if v.Name != nil && *v.Name == name {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,37 @@
+package skytap
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func TestAccDataSourceSkytapVPN_Basic(t *testing.T) {
+	name := utils.GetEnv("SKYTAP_VPN_NAME", "tftest-vpn")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSkytapVPNConfig_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.skytap_vpn.foo", "id"),
+					resource.TestCheckResourceAttr("data.skytap_vpn.foo", "name", name),
+					resource.TestCheckResourceAttrSet("data.skytap_vpn.foo", "enabled"),
+					resource.TestCheckResourceAttrSet("data.skytap_vpn.foo", "nat_enabled"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSkytapVPNConfig_basic(name string) string {
+	return fmt.Sprintf(`
+data "skytap_vpn" "foo" {
+	name = %q
+}`, name)
+}
@@ -0,0 +1,45 @@
+package skytap
+
+import (
+	"log"
+	"sync"
+)
+
+// mutexKV is a set of mutexes keyed by the ID of a Skytap object. It serializes the operations of the resources which
+// cannot run concurrently on the same object, such as the merges into an environment which are identified by
+// comparing the environment before and after the merge.
+type mutexKV struct {
+	lock  sync.Mutex
+	store map[string]*sync.Mutex
+}
+
+func newMutexKV() *mutexKV {
+	return &mutexKV{
+		store: make(map[string]*sync.Mutex),
+	}
+}
+
+// Lock the mutex of the given key, waiting until it is available
+func (m *mutexKV) Lock(key string) {
+	log.Printf("[DEBUG] locking %q", key)
+	m.get(key).Lock()
+	log.Printf("[DEBUG] locked %q", key)
+}
+
+// Unlock the mutex of the given key
+func (m *mutexKV) Unlock(key string) {
+	log.Printf("[DEBUG] unlocking %q", key)
+	m.get(key).Unlock()
+	log.Printf("[DEBUG] unlocked %q", key)
+}
+
+func (m *mutexKV) get(key string) *sync.Mutex {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	mutex, ok := m.store[key]
+	if !ok {
+		mutex = &sync.Mutex{}
+		m.store[key] = mutex
+	}
+	return mutex
+}
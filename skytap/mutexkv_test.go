@@ -0,0 +1,33 @@
+package skytap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMutexKV(t *testing.T) {
+	m := newMutexKV()
+
+	m.Lock("1")
+	// other keys are not locked
+	m.Lock("2")
+	m.Unlock("2")
+
+	locked := make(chan bool)
+	go func() {
+		m.Lock("1")
+		locked <- true
+		m.Unlock("1")
+	}()
+
+	select {
+	case <-locked:
+		t.Fatal("the key was locked twice")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	m.Unlock("1")
+	assert.True(t, <-locked)
+}
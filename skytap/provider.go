@@ -32,17 +32,32 @@ func Provider() *schema.Provider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"skytap_project":  dataSourceSkytapProject(),
-			"skytap_template": dataSourceSkytapTemplate(),
+			"skytap_project":   dataSourceSkytapProject(),
+			"skytap_template":  dataSourceSkytapTemplate(),
+			"skytap_vpn":       dataSourceSkytapVPN(),
+			"skytap_public_ip": dataSourceSkytapPublicIP(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"skytap_project":        resourceSkytapProject(),
-			"skytap_environment":    resourceSkytapEnvironment(),
-			"skytap_network":        resourceSkytapNetwork(),
-			"skytap_vm":             resourceSkytapVM(),
-			"skytap_label_category": resourceSkytapLabelCategory(),
-			"skytap_icnr_tunnel":    resourceSkytapICNRTunnel(),
+			"skytap_project":                    resourceSkytapProject(),
+			"skytap_environment":                resourceSkytapEnvironment(),
+			"skytap_network":                    resourceSkytapNetwork(),
+			"skytap_vm":                         resourceSkytapVM(),
+			"skytap_label_category":             resourceSkytapLabelCategory(),
+			"skytap_icnr_tunnel":                resourceSkytapICNRTunnel(),
+			"skytap_template":                   resourceSkytapTemplate(),
+			"skytap_network_vpn_attachment":     resourceSkytapNetworkVPNAttachment(),
+			"skytap_interface_public_ip":        resourceSkytapInterfacePublicIP(),
+			"skytap_interface_secondary_ip":     resourceSkytapInterfaceSecondaryIP(),
+			"skytap_schedule":                   resourceSkytapSchedule(),
+			"skytap_project_user":               resourceSkytapProjectUser(),
+			"skytap_project_group":              resourceSkytapProjectGroup(),
+			"skytap_project_environment":        resourceSkytapProjectEnvironment(),
+			"skytap_vm_note":                    resourceSkytapVMNote(),
+			"skytap_vm_credential":              resourceSkytapVMCredential(),
+			"skytap_sharing_portal":             resourceSkytapSharingPortal(),
+			"skytap_environment_template_merge": resourceSkytapEnvironmentTemplateMerge(),
+			"skytap_environment_vm":             resourceSkytapEnvironmentVM(),
 		},
 	}
 
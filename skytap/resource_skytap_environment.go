@@ -31,10 +31,20 @@ func resourceSkytapEnvironment() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"template_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
 				Description:  "ID of the template you want to create the environment from. If updated with a new ID, the environment will be recreated",
 				ForceNew:     true,
 				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"template_id", "source_environment_id"},
+			},
+
+			"source_environment_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "ID of the environment you want to copy the environment from. If updated with a new ID, the environment will be recreated",
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"template_id", "source_environment_id"},
 			},
 
 			"name": {
@@ -143,6 +153,215 @@ func resourceSkytapEnvironment() *schema.Resource {
 				Default:     nil,
 				Description: "The date and time that the environment will be automatically shut down. Format: yyyy/mm/dd hh:mm:ss. By default, the suspend time uses the UTC offset for the time zone defined in your user account settings. Optionally, a different UTC offset can be supplied (for example: 2018/07/20 14:20:00 -0000). The value in the API response is converted to your time zone",
 			},
+
+			"sequencing_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "If true, the VMs of the environment are started in the order defined by the `stage` blocks",
+			},
+
+			"stage": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Ordered list of VM startup stages. The VMs of a stage are started once the previous stage has finished and its delay has elapsed",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vm_ids": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							MinItems:    1,
+							Description: "IDs of the VMs started in this stage",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"delay_after_finish_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							Description:  "Number of seconds to wait after the VMs of this stage are running before starting the next stage",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+
+			"vms": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The VMs of the environment, including the VMs provided by its template",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the VM",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the VM",
+						},
+						"runstate": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Runstate of the VM",
+						},
+						"network_interface": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Network interfaces of the VM",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "ID of the network interface",
+									},
+									"interface_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Type of network that this network adapter is attached to",
+									},
+									"network_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "ID of the network that this network adapter is attached to",
+									},
+									"ip": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The IP address of the network adapter",
+									},
+									"hostname": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Hostname of the VM on the network",
+									},
+									"mac": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The MAC address of the network adapter",
+									},
+									"status": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The status of the network adapter",
+									},
+									"network_name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Name of the network that this network adapter is attached to",
+									},
+									"network_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Type of the network that this network adapter is attached to",
+									},
+									"public_ip": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "Public IP addresses attached to the network adapter",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"address": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The public IP address",
+												},
+												"dns_name": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The DNS name of the public IP address",
+												},
+											},
+										},
+									},
+									"published_service": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "Published services of the network adapter",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"id": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "ID of the published service",
+												},
+												"name": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "Name of the published service, when returned by the API",
+												},
+												"internal_port": {
+													Type:        schema.TypeInt,
+													Computed:    true,
+													Description: "The port that is exposed on the interface",
+												},
+												"external_ip": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The published service's external IP",
+												},
+												"external_port": {
+													Type:        schema.TypeInt,
+													Computed:    true,
+													Description: "The published service's external port",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"networks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The networks of the environment, including the networks provided by its template",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the network",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the network",
+						},
+						"subnet": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The subnet of the network, in CIDR notation",
+						},
+						"gateway": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The gateway IP address of the network",
+						},
+						"domain": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The domain name of the network",
+						},
+					},
+				},
+			},
+
+			"svms_by_architecture": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The number of SVMs consumed by the VMs of the environment, by architecture: `x86` and `power`",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
 		},
 	}
 }
@@ -150,12 +369,14 @@ func resourceSkytapEnvironment() *schema.Resource {
 func resourceSkytapEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*SkytapClient).environmentsClient
 
-	templateID := d.Get("template_id").(string)
 	name := d.Get("name").(string)
 
 	opts := skytap.CreateEnvironmentRequest{
-		TemplateID: &templateID,
-		Name:       &name,
+		Name: &name,
+	}
+
+	if v, ok := d.GetOk("template_id"); ok {
+		opts.TemplateID = utils.String(v.(string))
 	}
 
 	if v, ok := d.GetOk("outbound_traffic"); ok {
@@ -205,7 +426,15 @@ func resourceSkytapEnvironmentCreate(ctx context.Context, d *schema.ResourceData
 
 	log.Printf("[INFO] environment create")
 	log.Printf("[TRACE] environment create options: %v", spew.Sdump(opts))
-	environment, err := client.Create(ctx, &opts)
+	var environment *skytap.Environment
+	var err error
+	if v, ok := d.GetOk("source_environment_id"); ok {
+		environment, err = copyEnvironment(ctx, d, meta, v.(string), &opts)
+	} else if sequencingConfigured(d) {
+		environment, err = createEnvironment(ctx, d, meta, &opts)
+	} else {
+		environment, err = client.Create(ctx, &opts)
+	}
 	if err != nil {
 		return diag.Errorf("error creating environment: %v", err)
 	}
@@ -254,7 +483,8 @@ func resourceSkytapEnvironmentRead(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("error retrieving environment (%s): %v", id, err)
 	}
 
-	// The templateID is not set as it is used to build the environment and is not returned by the environment response.
+	// The templateID and sourceEnvironmentID are not set as they are used to build the environment and are not returned
+	// by the environment response.
 	// If this attribute is changed, this environment will be rebuilt
 	err = d.Set("name", environment.Name)
 	if err != nil {
@@ -303,6 +533,32 @@ func resourceSkytapEnvironmentRead(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	err = d.Set("sequencing_enabled", environment.SequencingEnabled)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// the stages are only tracked once configured, so that an environment keeps the stages of its template
+	// unless the configuration sets them
+	if _, ok := d.GetOk("stage"); ok {
+		err = d.Set("stage", flattenStages(environment.Stages))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err = d.Set("vms", flattenEnvironmentVMs(environment.VMs))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("networks", flattenEnvironmentNetworks(environment.Networks))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("svms_by_architecture", flattenSVMsByArchitecture(environment.SVMsByArchitecture))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if environment.LabelCount != nil && *environment.LabelCount > 0 {
 		if err = d.Set("label", flattenLabels(environment.Labels)); err != nil {
 			return diag.FromErr(err)
@@ -427,9 +683,176 @@ func resourceSkytapEnvironmentUpdate(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
+	if d.HasChanges("sequencing_enabled", "stage") {
+		if err := updateEnvironmentSequencing(ctx, d, meta, d.Id(), schema.TimeoutUpdate); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceSkytapEnvironmentRead(ctx, d, meta)
 }
 
+// createEnvironment creates the environment from its template and configures it before starting it. The SDK starts
+// the environment as soon as it is created, before the startup sequence can be applied.
+func createEnvironment(ctx context.Context, d *schema.ResourceData, meta interface{}, opts *skytap.CreateEnvironmentRequest) (*skytap.Environment, error) {
+	client := meta.(*SkytapClient).environmentsClient
+
+	log.Printf("[INFO] environment create from template: %s", *opts.TemplateID)
+	environment, err := meta.(*SkytapClient).environmentManagementClient.Create(ctx, &CreateEnvironmentRequest{
+		TemplateID: opts.TemplateID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if environment.ID == nil {
+		return nil, fmt.Errorf("environment ID is not set")
+	}
+	id := *environment.ID
+	// the environment is tracked right away so that a failure while configuring it taints it instead of leaking it
+	d.SetId(id)
+
+	if err = waitForEnvironmentReady(ctx, d, meta, id, schema.TimeoutCreate); err != nil {
+		return nil, err
+	}
+
+	environment, err = client.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving environment (%s): %v", id, err)
+	}
+
+	return configureEnvironment(ctx, d, meta, environment, opts)
+}
+
+// copyEnvironment copies the source environment and, once the copy completes, applies the arguments of the
+// create request to the copy as the SDK does for an environment created from a template.
+func copyEnvironment(ctx context.Context, d *schema.ResourceData, meta interface{}, sourceID string, opts *skytap.CreateEnvironmentRequest) (*skytap.Environment, error) {
+	client := meta.(*SkytapClient).environmentsClient
+
+	log.Printf("[INFO] environment copy: %s", sourceID)
+	environment, err := meta.(*SkytapClient).environmentManagementClient.Copy(ctx, &CopyEnvironmentRequest{
+		EnvironmentID: &sourceID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error copying environment (%s): %v", sourceID, err)
+	}
+	if environment.ID == nil {
+		return nil, fmt.Errorf("environment ID is not set")
+	}
+	id := *environment.ID
+	// the environment is tracked right away so that a failure while configuring it taints it instead of leaking it
+	d.SetId(id)
+
+	if err = waitForEnvironmentReady(ctx, d, meta, id, schema.TimeoutCreate); err != nil {
+		return nil, err
+	}
+
+	environment, err = client.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving environment (%s): %v", id, err)
+	}
+
+	// the tags and labels of the source environment are replaced by the configured ones
+	for _, tag := range environment.Tags {
+		if err = client.DeleteTag(ctx, id, *tag.ID); err != nil {
+			return nil, err
+		}
+	}
+	for _, label := range environment.Labels {
+		if err = client.DeleteLabel(ctx, id, *label.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return configureEnvironment(ctx, d, meta, environment, opts)
+}
+
+// configureEnvironment applies the arguments of the create request to an environment which is not started yet. The
+// startup sequence is applied first so that the first start of the environment follows it.
+func configureEnvironment(ctx context.Context, d *schema.ResourceData, meta interface{}, environment *skytap.Environment, opts *skytap.CreateEnvironmentRequest) (*skytap.Environment, error) {
+	client := meta.(*SkytapClient).environmentsClient
+
+	id := *environment.ID
+
+	if sequencingConfigured(d) {
+		if err := updateEnvironmentSequencing(ctx, d, meta, id, schema.TimeoutCreate); err != nil {
+			return nil, err
+		}
+	}
+
+	// the user data of the source environment is replaced as well, even when none is configured
+	userData := opts.UserData
+	if userData == nil {
+		userData = utils.String("")
+	}
+	if err := client.UpdateUserData(ctx, id, userData); err != nil {
+		return nil, err
+	}
+	if err := client.CreateTags(ctx, id, opts.Tags); err != nil {
+		return nil, err
+	}
+	if err := client.CreateLabels(ctx, id, opts.Labels); err != nil {
+		return nil, err
+	}
+
+	updateOpts := skytap.UpdateEnvironmentRequest{
+		Name:            opts.Name,
+		Description:     opts.Description,
+		DisableInternet: opts.DisableInternet,
+		Routable:        opts.Routable,
+		SuspendOnIdle:   opts.SuspendOnIdle,
+		SuspendAtTime:   opts.SuspendAtTime,
+		ShutdownOnIdle:  opts.ShutdownOnIdle,
+		ShutdownAtTime:  opts.ShutdownAtTime,
+	}
+	if environment.VMCount != nil && *environment.VMCount > 0 {
+		// the environment is expected to start its VMs, as an environment created by the SDK does
+		runstate := skytap.EnvironmentRunstateRunning
+		updateOpts.Runstate = &runstate
+	}
+
+	log.Printf("[TRACE] environment update options: %v", spew.Sdump(updateOpts))
+	environment, err := client.Update(ctx, id, &updateOpts)
+	if err != nil {
+		return nil, fmt.Errorf("error updating environment (%s): %v", id, err)
+	}
+
+	return environment, nil
+}
+
+// sequencingConfigured returns true when the VM startup sequence is set in the configuration,
+// so that an environment created from a template keeps the sequence of the template otherwise.
+func sequencingConfigured(d *schema.ResourceData) bool {
+	_, sequencingOk := d.GetOkExists("sequencing_enabled")
+	_, stageOk := d.GetOk("stage")
+	return sequencingOk || stageOk
+}
+
+func updateEnvironmentSequencing(ctx context.Context, d *schema.ResourceData, meta interface{}, id string, schemaTimeout string) error {
+	client := meta.(*SkytapClient).environmentManagementClient
+
+	stages := environmentStages(d.Get("stage").([]interface{}))
+	opts := UpdateSequencingRequest{}
+	if len(stages) > 0 || d.HasChange("stage") {
+		// an empty list removes the stages once all the stage blocks are removed
+		opts.Stages = &stages
+	}
+	if v, ok := d.GetOkExists("sequencing_enabled"); ok {
+		opts.SequencingEnabled = utils.Bool(v.(bool))
+	} else if len(stages) > 0 {
+		opts.SequencingEnabled = utils.Bool(true)
+	}
+
+	log.Printf("[INFO] environment sequencing update: %s", id)
+	log.Printf("[TRACE] environment sequencing update options: %v", spew.Sdump(opts))
+	environment, err := client.UpdateSequencing(ctx, id, &opts)
+	if err != nil {
+		return fmt.Errorf("error updating sequencing of environment (%s): %v", id, err)
+	}
+	log.Printf("[TRACE] environment sequencing updated: %v", spew.Sdump(environment))
+
+	return waitForEnvironmentReady(ctx, d, meta, id, schemaTimeout)
+}
+
 func waitForEnvironmentReady(ctx context.Context, d *schema.ResourceData, meta interface{}, environmentID string, schemaTimeout string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:    environmentPendingUpdateRunstates,
@@ -517,10 +940,20 @@ func environmentCreateRunstateRefreshFunc(
 		}
 
 		computedRunstate := skytap.EnvironmentRunstateRunning
-		for i := 0; i < *environment.VMCount; i++ {
-			if *environment.VMs[i].Runstate != skytap.VMRunstateRunning {
-				computedRunstate = skytap.EnvironmentRunstateBusy
-				break
+		if environment.StagedExecution != nil {
+			// The VMs are still being started stage by stage
+			computedRunstate = skytap.EnvironmentRunstateBusy
+		} else {
+			staged := environmentStagedVMIDs(environment)
+			for _, vm := range environment.VMs {
+				if staged != nil && !staged[*vm.ID] {
+					// VMs outside of the startup sequence are not started with the environment
+					continue
+				}
+				if *vm.Runstate != skytap.VMRunstateRunning {
+					computedRunstate = skytap.EnvironmentRunstateBusy
+					break
+				}
 			}
 		}
 
@@ -543,6 +976,11 @@ func environmentUpdateRunstateRefreshFunc(ctx context.Context, meta interface{},
 
 		log.Printf("[DEBUG] environment (%s): %s", environmentID, *environment.Runstate)
 
+		if environment.StagedExecution != nil {
+			log.Printf("[DEBUG] environment (%s) staged execution in progress", environmentID)
+			return environment, string(skytap.EnvironmentRunstateBusy), nil
+		}
+
 		return environment, string(*environment.Runstate), nil
 	}
 }
@@ -592,3 +1030,34 @@ func environmentCreateLabels(vs *schema.Set) []*skytap.CreateLabelRequest {
 	}
 	return createLabelsRequest
 }
+
+// environmentStagedVMIDs returns the IDs of the VMs started by the startup sequence, or nil if sequencing is disabled
+func environmentStagedVMIDs(environment *skytap.Environment) map[string]bool {
+	if environment.SequencingEnabled == nil || !*environment.SequencingEnabled || len(environment.Stages) == 0 {
+		return nil
+	}
+	staged := make(map[string]bool)
+	for _, stage := range environment.Stages {
+		for _, vmID := range stage.VMIDs {
+			staged[vmID] = true
+		}
+	}
+	return staged
+}
+
+func environmentStages(vs []interface{}) []skytap.Stage {
+	stages := make([]skytap.Stage, 0)
+	for i, v := range vs {
+		elem := v.(map[string]interface{})
+		vmIDs := make([]string, 0)
+		for _, vmID := range elem["vm_ids"].(*schema.Set).List() {
+			vmIDs = append(vmIDs, vmID.(string))
+		}
+		stages = append(stages, skytap.Stage{
+			Index:                   utils.Int(i),
+			DelayAfterFinishSeconds: utils.Int(elem["delay_after_finish_seconds"].(int)),
+			VMIDs:                   vmIDs,
+		})
+	}
+	return stages
+}
@@ -0,0 +1,256 @@
+package skytap
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/skytap/skytap-sdk-go/skytap"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func resourceSkytapEnvironmentTemplateMerge() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSkytapEnvironmentTemplateMergeCreate,
+		ReadContext:   resourceSkytapEnvironmentTemplateMergeRead,
+		DeleteContext: resourceSkytapEnvironmentTemplateMergeDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "ID of the environment the template is merged into",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"template_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "ID of the template whose VMs and networks are merged into the environment",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"vm_ids": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "IDs of the VMs added to the environment by the merge",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"network_ids": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "IDs of the networks added to the environment by the merge",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceSkytapEnvironmentTemplateMergeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).environmentsClient
+
+	environmentID := d.Get("environment_id").(string)
+	templateID := d.Get("template_id").(string)
+
+	template, err := meta.(*SkytapClient).templatesClient.Get(ctx, templateID)
+	if err != nil {
+		return diag.Errorf("error retrieving template (%s): %v", templateID, err)
+	}
+
+	if err = waitForEnvironmentReady(ctx, d, meta, environmentID, schema.TimeoutCreate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the merge response does not identify what was added, so the VMs and networks missing before the merge are
+	// matched with the ones of the template. The merges into the environment are serialized meanwhile.
+	locks := meta.(*SkytapClient).environmentLocks
+	locks.Lock(environmentID)
+	defer locks.Unlock(environmentID)
+
+	environment, err := client.Get(ctx, environmentID)
+	if err != nil {
+		return diag.Errorf("error retrieving environment (%s): %v", environmentID, err)
+	}
+	vmIDsBefore, networkIDsBefore := environmentResourceIDs(environment)
+
+	opts := MergeEnvironmentRequest{
+		TemplateID: &templateID,
+	}
+
+	log.Printf("[INFO] environment template merge: template (%s) into environment (%s)", templateID, environmentID)
+	log.Printf("[TRACE] environment template merge options: %v", spew.Sdump(opts))
+	environment, err = meta.(*SkytapClient).environmentManagementClient.Merge(ctx, environmentID, &opts)
+	if err != nil {
+		return diag.Errorf("error merging template (%s) into environment (%s): %v", templateID, environmentID, err)
+	}
+
+	// the merge is tracked right away so that its VMs and networks are not leaked if the environment fails to settle
+	d.SetId(resource.UniqueId())
+
+	err = d.Set("vm_ids", mergedVMIDs(vmIDsBefore, environment.VMs, template.VMs))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("network_ids", mergedNetworkIDs(networkIDsBefore, environment.Networks, template.Networks))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] environment template merged: %s", d.Id())
+
+	if err = waitForEnvironmentReady(ctx, d, meta, environmentID, schema.TimeoutCreate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSkytapEnvironmentTemplateMergeRead(ctx, d, meta)
+}
+
+func resourceSkytapEnvironmentTemplateMergeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).environmentsClient
+
+	environmentID := d.Get("environment_id").(string)
+	id := d.Id()
+
+	log.Printf("[INFO] retrieving environment template merge: %s", id)
+	environment, err := client.Get(ctx, environmentID)
+	if err != nil {
+		if utils.ResponseErrorIsNotFound(err) {
+			log.Printf("[DEBUG] environment (%s) was not found - removing template merge (%s) from state", environmentID, id)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("error retrieving environment (%s): %v", environmentID, err)
+	}
+
+	// the VMs and networks removed outside of Terraform are dropped
+	vmIDs, networkIDs := environmentResourceIDs(environment)
+	mergedVMIDs := remainingIDs(d.Get("vm_ids").(*schema.Set), vmIDs)
+	mergedNetworkIDs := remainingIDs(d.Get("network_ids").(*schema.Set), networkIDs)
+	if len(mergedVMIDs) == 0 && len(mergedNetworkIDs) == 0 {
+		log.Printf("[DEBUG] environment template merge (%s) has nothing left in the environment - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	err = d.Set("vm_ids", mergedVMIDs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("network_ids", mergedNetworkIDs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] environment template merge retrieved: %s", id)
+
+	return nil
+}
+
+func resourceSkytapEnvironmentTemplateMergeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vmsClient := meta.(*SkytapClient).vmsClient
+	networksClient := meta.(*SkytapClient).networksClient
+
+	environmentID := d.Get("environment_id").(string)
+	id := d.Id()
+
+	log.Printf("[INFO] destroying environment template merge: %s", id)
+
+	// the VMs are removed first as the networks cannot be removed while interfaces are attached to them
+	for _, vmID := range d.Get("vm_ids").(*schema.Set).List() {
+		log.Printf("[INFO] destroying merged VM: %s", vmID.(string))
+		err := vmsClient.Delete(ctx, environmentID, vmID.(string))
+		if err != nil && !utils.ResponseErrorIsNotFound(err) {
+			return diag.Errorf("error deleting VM (%s): %v", vmID.(string), err)
+		}
+	}
+
+	for _, networkID := range d.Get("network_ids").(*schema.Set).List() {
+		if err := waitForEnvironmentReady(ctx, d, meta, environmentID, schema.TimeoutDelete); err != nil {
+			return diag.FromErr(err)
+		}
+
+		log.Printf("[INFO] destroying merged network: %s", networkID.(string))
+		err := networksClient.Delete(ctx, environmentID, networkID.(string))
+		if err != nil && !utils.ResponseErrorIsNotFound(err) {
+			return diag.Errorf("error deleting network (%s): %v", networkID.(string), err)
+		}
+	}
+
+	log.Printf("[INFO] environment template merge destroyed: %s", id)
+
+	return nil
+}
+
+// mergedVMIDs returns the sorted IDs of the VMs merged from the source VMs, which are the VMs missing from before
+// whose name is the name of a source VM. Each source VM accounts for a single merged VM so that the VMs added by
+// another merge of the same source are not claimed.
+func mergedVMIDs(before map[string]bool, vms []skytap.VM, sourceVMs []skytap.VM) []string {
+	names := make(map[string]int)
+	for _, vm := range sourceVMs {
+		if vm.Name != nil {
+			names[*vm.Name]++
+		}
+	}
+	merged := make([]string, 0)
+	for _, vm := range vms {
+		if vm.ID == nil || vm.Name == nil || before[*vm.ID] || names[*vm.Name] == 0 {
+			continue
+		}
+		names[*vm.Name]--
+		merged = append(merged, *vm.ID)
+	}
+	sort.Strings(merged)
+	return merged
+}
+
+// mergedNetworkIDs returns the sorted IDs of the networks merged from the source networks, matched by name as the VMs
+func mergedNetworkIDs(before map[string]bool, networks []skytap.Network, sourceNetworks []skytap.Network) []string {
+	names := make(map[string]int)
+	for _, network := range sourceNetworks {
+		if network.Name != nil {
+			names[*network.Name]++
+		}
+	}
+	merged := make([]string, 0)
+	for _, network := range networks {
+		if network.ID == nil || network.Name == nil || before[*network.ID] || names[*network.Name] == 0 {
+			continue
+		}
+		names[*network.Name]--
+		merged = append(merged, *network.ID)
+	}
+	sort.Strings(merged)
+	return merged
+}
+
+// remainingIDs returns the sorted IDs of ids which are still in current
+func remainingIDs(ids *schema.Set, current map[string]bool) []string {
+	remaining := make([]string, 0)
+	for _, id := range ids.List() {
+		if current[id.(string)] {
+			remaining = append(remaining, id.(string))
+		}
+	}
+	sort.Strings(remaining)
+	return remaining
+}
@@ -0,0 +1,76 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func TestAccSkytapEnvironmentTemplateMerge_Basic(t *testing.T) {
+	templateID := utils.GetEnv("SKYTAP_TEMPLATE_ID", "1478959")
+	mergeTemplateID := utils.GetEnv("SKYTAP_TEMPLATE_ID2", "1877151")
+	uniqueSuffix := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSkytapEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSkytapEnvironmentTemplateMergeConfig_basic(uniqueSuffix, templateID, mergeTemplateID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapEnvironmentTemplateMergeExists("skytap_environment_template_merge.merge"),
+					resource.TestCheckResourceAttrSet("skytap_environment_template_merge.merge", "vm_ids.#"),
+				),
+			},
+		},
+	})
+}
+
+// Verifies the merged VMs are in the environment
+func testAccCheckSkytapEnvironmentTemplateMergeExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := getResource(s, name)
+		if err != nil {
+			return err
+		}
+
+		// retrieve the connection established in Provider configuration
+		client := testAccProvider.Meta().(*SkytapClient).environmentsClient
+		ctx := context.TODO()
+
+		environment, err := client.Get(ctx, rs.Primary.Attributes["environment_id"])
+		if err != nil {
+			return fmt.Errorf("error retrieving environment (%s): %v", rs.Primary.Attributes["environment_id"], err)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["vm_ids.#"])
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return fmt.Errorf("environment template merge (%s) did not add any VM", rs.Primary.ID)
+		}
+		if len(environment.VMs) <= count {
+			return fmt.Errorf("environment (%s) does not contain the VMs of both templates", *environment.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSkytapEnvironmentTemplateMergeConfig_basic(uniqueSuffix int, templateID string, mergeTemplateID string) string {
+	return testAccSkytapEnvironmentConfig_basic(uniqueSuffix, templateID, `["integration_test"]`) + fmt.Sprintf(`
+
+      resource "skytap_environment_template_merge" "merge" {
+        environment_id = skytap_environment.foo.id
+        template_id    = "%s"
+      }`, mergeTemplateID)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -74,12 +75,35 @@ func TestAccSkytapEnvironment_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr("skytap_environment.foo", "shutdown_on_idle", "0"),
 					resource.TestCheckResourceAttr("skytap_environment.foo", "shutdown_at_time", ""),
 					resource.TestCheckResourceAttr("skytap_environment.foo", "tags.#", "1"),
+					testAccCheckSkytapEnvironmentInventory("skytap_environment.foo", &environment),
 				),
 			},
 		},
 	})
 }
 
+// Verifies the computed VMs and networks match the environment
+func testAccCheckSkytapEnvironmentInventory(name string, environment *skytap.Environment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := getResource(s, name)
+		if err != nil {
+			return err
+		}
+
+		if rs.Primary.Attributes["vms.#"] != strconv.Itoa(len(environment.VMs)) {
+			return fmt.Errorf("environment (%s) has %d VMs but vms has %s elements", rs.Primary.ID, len(environment.VMs), rs.Primary.Attributes["vms.#"])
+		}
+		if rs.Primary.Attributes["networks.#"] != strconv.Itoa(len(environment.Networks)) {
+			return fmt.Errorf("environment (%s) has %d networks but networks has %s elements", rs.Primary.ID, len(environment.Networks), rs.Primary.Attributes["networks.#"])
+		}
+		if len(environment.VMs) > 0 && rs.Primary.Attributes["vms.0.id"] != *environment.VMs[0].ID {
+			return fmt.Errorf("environment (%s) VM ID (%s) expected but found (%s)", rs.Primary.ID, *environment.VMs[0].ID, rs.Primary.Attributes["vms.0.id"])
+		}
+
+		return nil
+	}
+}
+
 func TestAccSkytapEnvironment_Update(t *testing.T) {
 	templateID := utils.GetEnv("SKYTAP_TEMPLATE_ID", "1478959")
 	uniqueSuffix := acctest.RandInt()
@@ -175,6 +199,58 @@ func TestAccSkytapEnvironment_UpdateTags(t *testing.T) {
 	})
 }
 
+func TestAccSkytapEnvironment_Sequencing(t *testing.T) {
+	templateID := utils.GetEnv("SKYTAP_TEMPLATE_ID", "1478959")
+	uniqueSuffix := acctest.RandInt()
+	var environment skytap.Environment
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSkytapEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSkytapEnvironmentConfigBlock(uniqueSuffix, templateID, "", "sequencing_enabled = true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapEnvironmentExists("skytap_environment.foo", &environment),
+					resource.TestCheckResourceAttr("skytap_environment.foo", "sequencing_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccSkytapEnvironmentConfigBlock(uniqueSuffix, templateID, "", "sequencing_enabled = false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapEnvironmentExists("skytap_environment.foo", &environment),
+					resource.TestCheckResourceAttr("skytap_environment.foo", "sequencing_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSkytapEnvironment_Copy(t *testing.T) {
+	templateID := utils.GetEnv("SKYTAP_TEMPLATE_ID", "1478959")
+	uniqueSuffix := acctest.RandInt()
+	var environment skytap.Environment
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSkytapEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSkytapEnvironmentConfig_copy(uniqueSuffix, templateID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapEnvironmentExists("skytap_environment.copy", &environment),
+					resource.TestCheckResourceAttrPair("skytap_environment.copy", "source_environment_id", "skytap_environment.foo", "id"),
+					resource.TestCheckResourceAttr("skytap_environment.copy", "name", fmt.Sprintf("tftest-environment-copy-%d", uniqueSuffix)),
+					resource.TestCheckResourceAttr("skytap_environment.copy", "tags.#", "1"),
+					testAccCheckSkytapEnvironmentContainsTag(&environment, "copy"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSkytapEnvironment_UserData(t *testing.T) {
 	templateID := utils.GetEnv("SKYTAP_TEMPLATE_ID", "1478959")
 	uniqueSuffix := acctest.RandInt()
@@ -508,6 +584,17 @@ func testAccSkytapEnvironmentConfig_basic(uniqueSuffix int, templateID string, t
       }`, templateID, tags, uniqueSuffix)
 }
 
+func testAccSkytapEnvironmentConfig_copy(uniqueSuffix int, templateID string) string {
+	return testAccSkytapEnvironmentConfig_basic(uniqueSuffix, templateID, `["golden"]`) + fmt.Sprintf(`
+
+      resource "skytap_environment" "copy" {
+	    source_environment_id = skytap_environment.foo.id
+		tags = ["copy"]
+	    name = "tftest-environment-copy-%d"
+	    description = "This is an environment copied by the skytap terraform provider acceptance test"
+      }`, uniqueSuffix)
+}
+
 func testAccSkytapEnvironmentConfig_advanced(uniqueSuffix int, templateID string, tags string, disableInternet bool, routable bool) string {
 	return fmt.Sprintf(`
       resource "skytap_environment" "foo" {
@@ -0,0 +1,402 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/skytap/skytap-sdk-go/skytap"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func resourceSkytapEnvironmentVM() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSkytapEnvironmentVMCreate,
+		ReadContext:   resourceSkytapEnvironmentVMRead,
+		UpdateContext: resourceSkytapEnvironmentVMUpdate,
+		DeleteContext: resourceSkytapEnvironmentVMDelete,
+		CustomizeDiff: resourceSkytapEnvironmentVMCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "ID of the environment that contains the VM",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"vm_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "ID of the existing VM within the environment to manage",
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"vm_id", "vm_name"},
+			},
+
+			"vm_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Name of the existing VM within the environment to manage. The name must be unique within the environment",
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"vm_id", "vm_name"},
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "User-defined name of the VM",
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+
+			"cpus": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Number of CPUs allocated to this virtual machine",
+				ValidateFunc: validation.IntBetween(1, 12),
+			},
+
+			"max_cpus": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Maximum settable CPUs for the VM",
+			},
+
+			"ram": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Amount of RAM allocated to the VM",
+				ValidateFunc: validation.IntBetween(256, 131072),
+			},
+
+			"max_ram": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Maximum amount of RAM that can be allocated to the VM",
+			},
+
+			"architecture": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The architecture of the VM, `x86` or `power`",
+			},
+
+			"instance_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The instance type of an IBM Power VM",
+			},
+
+			"os_disk_size": {
+				Type:         schema.TypeInt,
+				Computed:     true,
+				Optional:     true,
+				Description:  "The size of the OS disk. The disk size is in MiB; it will be converted to GiB in the Skytap UI. The maximum disk size is 2,096,128 MiB (1.999 TiB)",
+				ValidateFunc: validation.IntBetween(2048, 2096128),
+			},
+
+			"allow_stop_for_update": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If false, a plan with changes which require the running VM to be stopped is rejected",
+			},
+
+			"stop_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      vmStopMethodShutdown,
+				Description:  "How the VM is stopped before an update which requires it: `shutdown` shuts the guest OS down, `halted` powers the VM off",
+				ValidateFunc: validation.StringInSlice([]string{vmStopMethodShutdown, vmStopMethodHalted}, false),
+			},
+
+			"shutdown_timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				Description:  "Number of seconds to wait for the guest OS to shut down before the VM is powered off",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"user_data": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "VM user data, available from the metadata server and the Skytap API. The user data of the VM is kept when not set",
+			},
+
+			"label": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "Set of labels for the instance. The labels of the VM are kept when none is set",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"category": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Label category that provides contextual meaning",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Label value used for reporting",
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSkytapEnvironmentVMCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).vmsClient
+
+	environmentID := d.Get("environment_id").(string)
+
+	if err := waitForEnvironmentReady(ctx, d, meta, environmentID, schema.TimeoutCreate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	vm, err := findEnvironmentVM(ctx, d, meta, environmentID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if _, ok := d.GetOk("os_disk_size"); ok && isPowerArchitecture(vm.Hardware) {
+		return diag.Errorf("the 'os_disk_size' argument is not supported by IBM Power VMs")
+	}
+	id := *vm.ID
+	d.SetId(id)
+
+	log.Printf("[INFO] adopting environment VM: %s", id)
+	log.Printf("[TRACE] adopting environment VM: %v", spew.Sdump(vm))
+
+	// the limits are needed to validate the requested cpus and ram
+	err = d.Set("max_cpus", vm.Hardware.MaxCPUs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("max_ram", vm.Hardware.MaxRAM)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if osDiskSize, ok := d.GetOk("os_disk_size"); ok && osDiskIndex(vm.Hardware.Disks) >= 0 {
+		osDisk := vm.Hardware.Disks[osDiskIndex(vm.Hardware.Disks)]
+		if err = checkDiskNotShrunk(*osDisk.Size, osDiskSize.(int), "OS"); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// the labels and user data of the template VM are only replaced when they are configured
+	rawConfig := d.GetRawConfig()
+	if rawConfig.GetAttr("label").LengthInt() > 0 {
+		for _, label := range vm.Labels {
+			if err = client.DeleteLabel(ctx, environmentID, id, *label.ID); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+	if userData := rawConfig.GetAttr("user_data"); !userData.IsNull() && userData.AsString() == "" {
+		if err = client.UpdateUserData(ctx, environmentID, id, utils.String("")); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err = updateEnvironmentVM(ctx, d, meta, schema.TimeoutCreate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] environment VM adopted: %s", id)
+
+	return resourceSkytapEnvironmentVMRead(ctx, d, meta)
+}
+
+func resourceSkytapEnvironmentVMRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).vmsClient
+
+	environmentID := d.Get("environment_id").(string)
+	id := d.Id()
+
+	log.Printf("[INFO] retrieving environment VM: %s", id)
+	vm, err := client.Get(ctx, environmentID, id)
+	if err != nil {
+		if utils.ResponseErrorIsNotFound(err) {
+			log.Printf("[DEBUG] environment VM (%s) was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("error retrieving environment VM (%s): %v", id, err)
+	}
+
+	err = d.Set("vm_id", vm.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("name", vm.Name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("cpus", vm.Hardware.CPUs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("ram", vm.Hardware.RAM)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("max_cpus", vm.Hardware.MaxCPUs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("max_ram", vm.Hardware.MaxRAM)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("architecture", vm.Hardware.Architecture)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("instance_type", vm.Hardware.InstanceType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// the disks of an IBM Power VM are not managed
+	if osDisk := osDiskIndex(vm.Hardware.Disks); osDisk >= 0 && !isPowerArchitecture(vm.Hardware) {
+		err = d.Set("os_disk_size", *vm.Hardware.Disks[osDisk].Size)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	userData, err := client.GetUserData(ctx, environmentID, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("user_data", userData)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("label", flattenLabels(vm.Labels)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] environment VM retrieved: %s", id)
+	log.Printf("[TRACE] environment VM retrieved: %v", spew.Sdump(vm))
+
+	return nil
+}
+
+func resourceSkytapEnvironmentVMUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := updateEnvironmentVM(ctx, d, meta, schema.TimeoutUpdate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSkytapEnvironmentVMRead(ctx, d, meta)
+}
+
+func resourceSkytapEnvironmentVMDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// the VM belongs to the environment, so it is only removed from the state
+	log.Printf("[INFO] releasing environment VM: %s", d.Id())
+	d.SetId("")
+
+	return nil
+}
+
+// findEnvironmentVM returns the VM of the environment matching either `vm_id` or `vm_name`
+func findEnvironmentVM(ctx context.Context, d *schema.ResourceData, meta interface{}, environmentID string) (*skytap.VM, error) {
+	client := meta.(*SkytapClient).environmentsClient
+
+	environment, err := client.Get(ctx, environmentID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving environment (%s): %v", environmentID, err)
+	}
+
+	vmID := d.Get("vm_id").(string)
+	vmName := d.Get("vm_name").(string)
+	var found *skytap.VM
+	for idx, vm := range environment.VMs {
+		if (vmID != "" && *vm.ID == vmID) || (vmName != "" && vm.Name != nil && *vm.Name == vmName) {
+			if found != nil {
+				return nil, fmt.Errorf("more than one VM named (%s) found in environment (%s)", vmName, environmentID)
+			}
+			found = &environment.VMs[idx]
+		}
+	}
+	if found == nil {
+		if vmID != "" {
+			return nil, fmt.Errorf("VM (%s) not found in environment (%s)", vmID, environmentID)
+		}
+		return nil, fmt.Errorf("VM named (%s) not found in environment (%s)", vmName, environmentID)
+	}
+	return found, nil
+}
+
+// environmentVMPowerCycleKeys are the arguments whose update stops the environment VM
+var environmentVMPowerCycleKeys = []string{"cpus", "ram", "os_disk_size"}
+
+// updateEnvironmentVM applies the name, hardware, user data and label changes to the VM with the update steps of
+// skytap_vm, keeping its disks. Only the hardware changes stop the VM.
+func updateEnvironmentVM(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout string) error {
+	environmentID := d.Get("environment_id").(string)
+	id := d.Id()
+
+	vm, err := beginVMUpdate(ctx, d, meta, environmentID, id, timeout, vmPowerCycleChanges(d, environmentVMPowerCycleKeys))
+	if err != nil {
+		return err
+	}
+
+	if !d.HasChanges(environmentVMPowerCycleKeys...) {
+		return nil
+	}
+
+	// Previous state to start
+	previousState := vm.Runstate
+
+	if err = updateVMSizing(ctx, d, meta, environmentID, id, vm, timeout); err != nil {
+		return err
+	}
+
+	if err = updateVMOSDiskSize(ctx, d, meta, environmentID, id, vm, timeout); err != nil {
+		return err
+	}
+
+	// Set VM to previous running state
+	if err = forceRunstate(ctx, meta, environmentID, id, *previousState); err != nil {
+		return err
+	}
+
+	return waitForVMUpdated(ctx, d, meta, timeout)
+}
+
+// resourceSkytapEnvironmentVMCustomizeDiff rejects the changes which would stop the running VM when
+// `allow_stop_for_update` is false
+func resourceSkytapEnvironmentVMCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	return checkVMStopAllowedDiff(ctx, d, meta, vmPowerCycleChanges(d, environmentVMPowerCycleKeys))
+}
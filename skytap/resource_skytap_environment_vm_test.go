@@ -0,0 +1,105 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func TestAccSkytapEnvironmentVM_Basic(t *testing.T) {
+	templateID := utils.GetEnv("SKYTAP_TEMPLATE_ID", "1478959")
+	uniqueSuffix := acctest.RandInt()
+	var vmName string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			vmName = testAccTemplateVMName(t, templateID)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSkytapEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSkytapEnvironmentVMConfig_basic(uniqueSuffix, templateID, t.Name(), vmName, "tftest-adopted", "label_value"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapEnvironmentVMExists("skytap_environment_vm.adopted"),
+					resource.TestCheckResourceAttrSet("skytap_environment_vm.adopted", "vm_id"),
+					resource.TestCheckResourceAttr("skytap_environment_vm.adopted", "name", "tftest-adopted"),
+					resource.TestCheckResourceAttr("skytap_environment_vm.adopted", "label.#", "1"),
+				),
+			},
+			{
+				Config: testAccSkytapEnvironmentVMConfig_basic(uniqueSuffix, templateID, t.Name(), vmName, "tftest-adopted-renamed", "label_value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapEnvironmentVMExists("skytap_environment_vm.adopted"),
+					resource.TestCheckResourceAttr("skytap_environment_vm.adopted", "name", "tftest-adopted-renamed"),
+					resource.TestCheckResourceAttr("skytap_environment_vm.adopted", "label.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// Retrieves the name of the first VM of the template, the environment VM adopted by the test
+func testAccTemplateVMName(t *testing.T, templateID string) string {
+	// the provider is not configured yet when the test is set up
+	client, err := sharedClientForRegion("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template, err := client.templatesClient.Get(context.TODO(), templateID)
+	if err != nil {
+		t.Fatalf("error retrieving template (%s): %v", templateID, err)
+	}
+	if len(template.VMs) == 0 {
+		t.Fatalf("template (%s) has no VMs", templateID)
+	}
+	return *template.VMs[0].Name
+}
+
+// Verifies the VM exists in the environment with the configured name
+func testAccCheckSkytapEnvironmentVMExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := getResource(s, name)
+		if err != nil {
+			return err
+		}
+
+		// retrieve the connection established in Provider configuration
+		client := testAccProvider.Meta().(*SkytapClient).vmsClient
+		ctx := context.TODO()
+
+		vm, err := client.Get(ctx, rs.Primary.Attributes["environment_id"], rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error retrieving environment VM (%s): %v", rs.Primary.ID, err)
+		}
+		if *vm.Name != rs.Primary.Attributes["name"] {
+			return fmt.Errorf("environment VM (%s) is named (%s) instead of (%s)", rs.Primary.ID, *vm.Name, rs.Primary.Attributes["name"])
+		}
+
+		return nil
+	}
+}
+
+func testAccSkytapEnvironmentVMConfig_basic(uniqueSuffix int, templateID string, labelSuffix string, vmName string, name string, labelValue string) string {
+	return testAccSkytapEnvironmentConfig_basic(uniqueSuffix, templateID, `["integration_test"]`) + labelRequirements(labelSuffix) + fmt.Sprintf(`
+
+      resource "skytap_environment_vm" "adopted" {
+        environment_id = skytap_environment.foo.id
+        vm_name        = "%s"
+        name           = "%s"
+        user_data      = "adopted"
+
+        label {
+          category = skytap_label_category.environment_label.name
+          value    = "%s"
+        }
+      }`, vmName, name, labelValue)
+}
@@ -0,0 +1,163 @@
+package skytap
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/skytap/skytap-sdk-go/skytap"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func resourceSkytapInterfacePublicIP() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSkytapInterfacePublicIPCreate,
+		ReadContext:   resourceSkytapInterfacePublicIPRead,
+		DeleteContext: resourceSkytapInterfacePublicIPDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "ID of the environment containing the VM",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"vm_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "ID of the VM owning the network interface",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"network_interface_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "ID of the network interface the public IP address is attached to",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The public IP address of the account to attach",
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"dns_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The DNS name of the public IP address",
+			},
+		},
+	}
+}
+
+func resourceSkytapInterfacePublicIPCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).publicIPsClient
+
+	environmentID := d.Get("environment_id").(string)
+	vmID := d.Get("vm_id").(string)
+	interfaceID := d.Get("network_interface_id").(string)
+	address := d.Get("address").(string)
+
+	log.Printf("[INFO] public IP attach: %s to interface (%s)", address, interfaceID)
+	if err := client.Attach(ctx, environmentID, vmID, interfaceID, address); err != nil {
+		return diag.Errorf("error attaching public IP (%s) to interface (%s): %v", address, interfaceID, err)
+	}
+
+	d.SetId(address)
+
+	log.Printf("[INFO] public IP attached: %s", address)
+
+	return resourceSkytapInterfacePublicIPRead(ctx, d, meta)
+}
+
+func resourceSkytapInterfacePublicIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).interfacesClient
+
+	environmentID := d.Get("environment_id").(string)
+	vmID := d.Get("vm_id").(string)
+	interfaceID := d.Get("network_interface_id").(string)
+	id := d.Id()
+
+	log.Printf("[INFO] retrieving public IP attachment: %s", id)
+	networkInterface, err := client.Get(ctx, environmentID, vmID, interfaceID)
+	if err != nil {
+		if utils.ResponseErrorIsNotFound(err) {
+			log.Printf("[DEBUG] interface (%s) was not found - removing public IP (%s) from state", interfaceID, id)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("error retrieving interface (%s): %v", interfaceID, err)
+	}
+
+	attachment := findPublicIPAttachment(networkInterface, id)
+	if attachment == nil {
+		log.Printf("[DEBUG] public IP (%s) is not attached - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	err = d.Set("address", attachment.Address)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("dns_name", attachment.DNSName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] public IP attachment retrieved: %s", id)
+	log.Printf("[TRACE] public IP attachment retrieved: %v", spew.Sdump(attachment))
+
+	return nil
+}
+
+func resourceSkytapInterfacePublicIPDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).publicIPsClient
+
+	environmentID := d.Get("environment_id").(string)
+	vmID := d.Get("vm_id").(string)
+	interfaceID := d.Get("network_interface_id").(string)
+	id := d.Id()
+
+	log.Printf("[INFO] public IP detach: %s", id)
+	err := client.Detach(ctx, environmentID, vmID, interfaceID, id)
+	if err != nil {
+		if utils.ResponseErrorIsNotFound(err) {
+			log.Printf("[DEBUG] public IP (%s) was not found - assuming removed", id)
+			return nil
+		}
+
+		return diag.Errorf("error detaching public IP (%s) from interface (%s): %v", id, interfaceID, err)
+	}
+
+	log.Printf("[INFO] public IP detached: %s", id)
+
+	return nil
+}
+
+func findPublicIPAttachment(networkInterface *skytap.Interface, address string) *skytap.PublicIPAttachment {
+	for i, attachment := range networkInterface.PublicIPAttachments {
+		if attachment.Address != nil && *attachment.Address == address {
+			return &networkInterface.PublicIPAttachments[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func TestAccSkytapInterfacePublicIP_Basic(t *testing.T) {
+	templateID, vmID, newEnvTemplateID := setupEnvironment()
+	region := utils.GetEnv("SKYTAP_PUBLIC_IP_REGION", "US-West")
+	uniqueSuffixEnv := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSkytapEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSkytapInterfacePublicIPConfig_basic(newEnvTemplateID, templateID, vmID, uniqueSuffixEnv, region),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapInterfacePublicIPExists("skytap_interface_public_ip.foo"),
+					resource.TestCheckResourceAttrPair("skytap_interface_public_ip.foo", "address", "data.skytap_public_ip.foo", "address"),
+					resource.TestCheckResourceAttrSet("skytap_interface_public_ip.foo", "dns_name"),
+				),
+			},
+			{
+				// the attached address is still picked once it is no longer free
+				Config:   testAccSkytapInterfacePublicIPConfig_basic(newEnvTemplateID, templateID, vmID, uniqueSuffixEnv, region),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// Verifies the public IP is attached to the interface
+func testAccCheckSkytapInterfacePublicIPExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := getResource(s, name)
+		if err != nil {
+			return err
+		}
+
+		// retrieve the connection established in Provider configuration
+		client := testAccProvider.Meta().(*SkytapClient).interfacesClient
+		ctx := context.TODO()
+
+		environmentID := rs.Primary.Attributes["environment_id"]
+		vmID := rs.Primary.Attributes["vm_id"]
+		interfaceID := rs.Primary.Attributes["network_interface_id"]
+
+		networkInterface, err := client.Get(ctx, environmentID, vmID, interfaceID)
+		if err != nil {
+			return fmt.Errorf("error retrieving interface (%s): %v", interfaceID, err)
+		}
+
+		if findPublicIPAttachment(networkInterface, rs.Primary.ID) == nil {
+			return fmt.Errorf("public IP (%s) is not attached to interface (%s)", rs.Primary.ID, interfaceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSkytapInterfacePublicIPConfig_basic(envTemplateID string, templateID string, vmID string, uniqueSuffixEnv int, region string) string {
+	return testAccSkytapVMConfig_typical(envTemplateID, templateID, vmID, uniqueSuffixEnv, 8080, "", "") + fmt.Sprintf(`
+
+    data "skytap_public_ip" "foo" {
+      region       = %q
+      available    = true
+      interface_id = tolist(skytap_vm.cassandra1.network_interface)[0].id
+    }
+
+    resource "skytap_interface_public_ip" "foo" {
+      environment_id       = skytap_environment.my_new_environment.id
+      vm_id                = skytap_vm.cassandra1.id
+      network_interface_id = tolist(skytap_vm.cassandra1.network_interface)[0].id
+      address              = data.skytap_public_ip.foo.address
+    }`, region)
+}
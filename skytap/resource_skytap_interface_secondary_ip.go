@@ -0,0 +1,145 @@
+package skytap
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func resourceSkytapInterfaceSecondaryIP() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSkytapInterfaceSecondaryIPCreate,
+		ReadContext:   resourceSkytapInterfaceSecondaryIPRead,
+		DeleteContext: resourceSkytapInterfaceSecondaryIPDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "ID of the environment containing the VM",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"vm_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "ID of the VM owning the network interface",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"network_interface_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "ID of the network interface the secondary IP address is added to",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"ip": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The secondary IP address. It must be within the subnet of the network the interface is attached to",
+				ValidateFunc: validation.IsIPAddress,
+			},
+		},
+	}
+}
+
+func resourceSkytapInterfaceSecondaryIPCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).secondaryIPsClient
+
+	environmentID := d.Get("environment_id").(string)
+	vmID := d.Get("vm_id").(string)
+	interfaceID := d.Get("network_interface_id").(string)
+
+	opts := CreateSecondaryIPRequest{
+		IP: utils.String(d.Get("ip").(string)),
+	}
+
+	log.Printf("[INFO] secondary IP create")
+	log.Printf("[TRACE] secondary IP create options: %v", spew.Sdump(opts))
+	secondaryIP, err := client.Create(ctx, environmentID, vmID, interfaceID, &opts)
+	if err != nil {
+		return diag.Errorf("error creating secondary IP on interface (%s): %v", interfaceID, err)
+	}
+
+	if secondaryIP.ID == nil {
+		return diag.Errorf("secondary IP ID is not set")
+	}
+	d.SetId(*secondaryIP.ID)
+
+	log.Printf("[INFO] secondary IP created: %s", *secondaryIP.ID)
+	log.Printf("[TRACE] secondary IP created: %v", spew.Sdump(secondaryIP))
+
+	return resourceSkytapInterfaceSecondaryIPRead(ctx, d, meta)
+}
+
+func resourceSkytapInterfaceSecondaryIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).secondaryIPsClient
+
+	environmentID := d.Get("environment_id").(string)
+	vmID := d.Get("vm_id").(string)
+	interfaceID := d.Get("network_interface_id").(string)
+	id := d.Id()
+
+	log.Printf("[INFO] retrieving secondary IP: %s", id)
+	secondaryIP, err := client.Get(ctx, environmentID, vmID, interfaceID, id)
+	if err != nil {
+		if utils.ResponseErrorIsNotFound(err) {
+			log.Printf("[DEBUG] secondary IP (%s) was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("error retrieving secondary IP (%s): %v", id, err)
+	}
+
+	err = d.Set("ip", secondaryIP.Address)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] secondary IP retrieved: %s", id)
+	log.Printf("[TRACE] secondary IP retrieved: %v", spew.Sdump(secondaryIP))
+
+	return nil
+}
+
+func resourceSkytapInterfaceSecondaryIPDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).secondaryIPsClient
+
+	environmentID := d.Get("environment_id").(string)
+	vmID := d.Get("vm_id").(string)
+	interfaceID := d.Get("network_interface_id").(string)
+	id := d.Id()
+
+	log.Printf("[INFO] destroying secondary IP: %s", id)
+	err := client.Delete(ctx, environmentID, vmID, interfaceID, id)
+	if err != nil {
+		if utils.ResponseErrorIsNotFound(err) {
+			log.Printf("[DEBUG] secondary IP (%s) was not found - assuming removed", id)
+			return nil
+		}
+
+		return diag.Errorf("error deleting secondary IP (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] secondary IP destroyed: %s", id)
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSkytapInterfaceSecondaryIP_Basic(t *testing.T) {
+	templateID, vmID, newEnvTemplateID := setupEnvironment()
+	uniqueSuffixEnv := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSkytapEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSkytapInterfaceSecondaryIPConfig_basic(newEnvTemplateID, templateID, vmID, uniqueSuffixEnv),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapInterfaceSecondaryIPExists("skytap_interface_secondary_ip.first"),
+					testAccCheckSkytapInterfaceSecondaryIPExists("skytap_interface_secondary_ip.second"),
+					resource.TestCheckResourceAttr("skytap_interface_secondary_ip.first", "ip", "10.0.3.10"),
+					resource.TestCheckResourceAttr("skytap_interface_secondary_ip.second", "ip", "10.0.3.11"),
+				),
+			},
+		},
+	})
+}
+
+// Verifies the secondary IP exists
+func testAccCheckSkytapInterfaceSecondaryIPExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := getResource(s, name)
+		if err != nil {
+			return err
+		}
+
+		// retrieve the connection established in Provider configuration
+		client := testAccProvider.Meta().(*SkytapClient).secondaryIPsClient
+		ctx := context.TODO()
+
+		environmentID := rs.Primary.Attributes["environment_id"]
+		vmID := rs.Primary.Attributes["vm_id"]
+		interfaceID := rs.Primary.Attributes["network_interface_id"]
+
+		_, err = client.Get(ctx, environmentID, vmID, interfaceID, rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error retrieving secondary IP (%s): %v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccSkytapInterfaceSecondaryIPConfig_basic(envTemplateID string, templateID string, vmID string, uniqueSuffixEnv int) string {
+	return testAccSkytapVMConfig_typical(envTemplateID, templateID, vmID, uniqueSuffixEnv, 8080, "", "") + `
+
+    resource "skytap_interface_secondary_ip" "first" {
+      environment_id       = skytap_environment.my_new_environment.id
+      vm_id                = skytap_vm.cassandra1.id
+      network_interface_id = tolist(skytap_vm.cassandra1.network_interface)[0].id
+      ip                   = "10.0.3.10"
+    }
+
+    resource "skytap_interface_secondary_ip" "second" {
+      environment_id       = skytap_environment.my_new_environment.id
+      vm_id                = skytap_vm.cassandra1.id
+      network_interface_id = tolist(skytap_vm.cassandra1.network_interface)[0].id
+      ip                   = "10.0.3.11"
+    }`
+}
@@ -0,0 +1,259 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/skytap/skytap-sdk-go/skytap"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func resourceSkytapNetworkVPNAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSkytapNetworkVPNAttachmentCreate,
+		ReadContext:   resourceSkytapNetworkVPNAttachmentRead,
+		UpdateContext: resourceSkytapNetworkVPNAttachmentUpdate,
+		DeleteContext: resourceSkytapNetworkVPNAttachmentDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "ID of the environment containing the network",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"network_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "ID of the network to attach to the VPN",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"vpn_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "ID of the VPN or private network connection the network is attached to",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"connected": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the attached network is connected to the VPN",
+			},
+
+			"vpn_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the VPN",
+			},
+
+			"nat_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether network address translation is enabled on the VPN",
+			},
+
+			"remote_subnets": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The subnets on the remote side of the VPN",
+			},
+
+			"remote_peer_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IP address of the remote VPN peer",
+			},
+
+			"subnet": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The subnet of the attached network",
+			},
+		},
+	}
+}
+
+func resourceSkytapNetworkVPNAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).vpnsClient
+
+	environmentID := d.Get("environment_id").(string)
+	networkID := d.Get("network_id").(string)
+	vpnID := d.Get("vpn_id").(string)
+
+	log.Printf("[INFO] VPN attachment create: network (%s), VPN (%s)", networkID, vpnID)
+	attachment, err := client.Attach(ctx, environmentID, networkID, vpnID)
+	if err != nil {
+		return diag.Errorf("error attaching network (%s) to VPN (%s): %v", networkID, vpnID, err)
+	}
+
+	if attachment.ID != nil {
+		d.SetId(*attachment.ID)
+	} else {
+		d.SetId(fmt.Sprintf("%s-%s", networkID, vpnID))
+	}
+
+	log.Printf("[INFO] VPN attachment created: %s", d.Id())
+	log.Printf("[TRACE] VPN attachment created: %v", spew.Sdump(attachment))
+
+	if d.Get("connected").(bool) {
+		if err = connectNetworkVPNAttachment(ctx, d, meta, true); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceSkytapNetworkVPNAttachmentRead(ctx, d, meta)
+}
+
+func resourceSkytapNetworkVPNAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).networksClient
+
+	environmentID := d.Get("environment_id").(string)
+	networkID := d.Get("network_id").(string)
+	vpnID := d.Get("vpn_id").(string)
+	id := d.Id()
+
+	log.Printf("[INFO] retrieving VPN attachment: %s", id)
+	network, err := client.Get(ctx, environmentID, networkID)
+	if err != nil {
+		if utils.ResponseErrorIsNotFound(err) {
+			log.Printf("[DEBUG] network (%s) was not found - removing VPN attachment (%s) from state", networkID, id)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("error retrieving network (%s): %v", networkID, err)
+	}
+
+	attachment := findVPNAttachment(network, vpnID)
+	if attachment == nil {
+		log.Printf("[DEBUG] VPN attachment (%s) was not found - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	err = d.Set("connected", attachment.Connected)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("subnet", network.Subnet)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if attachment.VPN != nil {
+		err = d.Set("vpn_name", attachment.VPN.Name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = d.Set("nat_enabled", attachment.VPN.NatEnabled)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = d.Set("remote_subnets", attachment.VPN.RemoteSubnets)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = d.Set("remote_peer_ip", attachment.VPN.RemotePeerIP)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[INFO] VPN attachment retrieved: %s", id)
+	log.Printf("[TRACE] VPN attachment retrieved: %v", spew.Sdump(attachment))
+
+	return nil
+}
+
+func resourceSkytapNetworkVPNAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("connected") {
+		if err := connectNetworkVPNAttachment(ctx, d, meta, d.Get("connected").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceSkytapNetworkVPNAttachmentRead(ctx, d, meta)
+}
+
+func resourceSkytapNetworkVPNAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).vpnsClient
+
+	environmentID := d.Get("environment_id").(string)
+	networkID := d.Get("network_id").(string)
+	vpnID := d.Get("vpn_id").(string)
+	id := d.Id()
+
+	// A connected network has to be disconnected before it can be detached.
+	if d.Get("connected").(bool) {
+		if err := connectNetworkVPNAttachment(ctx, d, meta, false); err != nil {
+			if utils.ResponseErrorIsNotFound(err) {
+				log.Printf("[DEBUG] VPN attachment (%s) was not found - assuming removed", id)
+				return nil
+			}
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[INFO] destroying VPN attachment: %s", id)
+	err := client.Detach(ctx, environmentID, networkID, vpnID)
+	if err != nil {
+		if utils.ResponseErrorIsNotFound(err) {
+			log.Printf("[DEBUG] VPN attachment (%s) was not found - assuming removed", id)
+			return nil
+		}
+
+		return diag.Errorf("error detaching network (%s) from VPN (%s): %v", networkID, vpnID, err)
+	}
+
+	log.Printf("[INFO] VPN attachment destroyed: %s", id)
+
+	return nil
+}
+
+func connectNetworkVPNAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}, connected bool) error {
+	client := meta.(*SkytapClient).vpnsClient
+
+	environmentID := d.Get("environment_id").(string)
+	networkID := d.Get("network_id").(string)
+	vpnID := d.Get("vpn_id").(string)
+
+	log.Printf("[INFO] VPN attachment (%s) connected: %t", d.Id(), connected)
+	attachment, err := client.Connect(ctx, environmentID, networkID, vpnID, connected)
+	if err != nil {
+		if utils.ResponseErrorIsNotFound(err) {
+			return err
+		}
+		return fmt.Errorf("error changing connection of network (%s) to VPN (%s): %v", networkID, vpnID, err)
+	}
+	log.Printf("[TRACE] VPN attachment updated: %v", spew.Sdump(attachment))
+
+	return nil
+}
+
+func findVPNAttachment(network *skytap.Network, vpnID string) *skytap.VPNAttachment {
+	for i, attachment := range network.VPNAttachments {
+		if attachment.VPN != nil && attachment.VPN.ID != nil && *attachment.VPN.ID == vpnID {
+			return &network.VPNAttachments[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func TestAccSkytapNetworkVPNAttachment_Basic(t *testing.T) {
+	templateID := utils.GetEnv("SKYTAP_TEMPLATE_ID", "1478959")
+	vpnName := utils.GetEnv("SKYTAP_VPN_NAME", "tftest-vpn")
+	uniqueSuffixEnv := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSkytapEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSkytapNetworkVPNAttachmentConfig_basic(templateID, vpnName, uniqueSuffixEnv, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapNetworkVPNAttachmentExists("skytap_network_vpn_attachment.foo"),
+					resource.TestCheckResourceAttrPair("skytap_network_vpn_attachment.foo", "vpn_id", "data.skytap_vpn.foo", "id"),
+					resource.TestCheckResourceAttr("skytap_network_vpn_attachment.foo", "vpn_name", vpnName),
+					resource.TestCheckResourceAttr("skytap_network_vpn_attachment.foo", "connected", "false"),
+					resource.TestCheckResourceAttr("skytap_network_vpn_attachment.foo", "subnet", "10.0.9.0/24"),
+					resource.TestCheckResourceAttrSet("skytap_network_vpn_attachment.foo", "nat_enabled"),
+				),
+			},
+			{
+				Config: testAccSkytapNetworkVPNAttachmentConfig_basic(templateID, vpnName, uniqueSuffixEnv, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapNetworkVPNAttachmentExists("skytap_network_vpn_attachment.foo"),
+					resource.TestCheckResourceAttr("skytap_network_vpn_attachment.foo", "connected", "true"),
+				),
+			},
+		},
+	})
+}
+
+// Verifies the network is attached to the VPN
+func testAccCheckSkytapNetworkVPNAttachmentExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := getResource(s, name)
+		if err != nil {
+			return err
+		}
+
+		// retrieve the connection established in Provider configuration
+		client := testAccProvider.Meta().(*SkytapClient).networksClient
+		ctx := context.TODO()
+
+		environmentID := rs.Primary.Attributes["environment_id"]
+		networkID := rs.Primary.Attributes["network_id"]
+		vpnID := rs.Primary.Attributes["vpn_id"]
+
+		network, err := client.Get(ctx, environmentID, networkID)
+		if err != nil {
+			return fmt.Errorf("error retrieving network (%s): %v", networkID, err)
+		}
+
+		if findVPNAttachment(network, vpnID) == nil {
+			return fmt.Errorf("network (%s) is not attached to VPN (%s)", networkID, vpnID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSkytapNetworkVPNAttachmentConfig_basic(templateID string, vpnName string, uniqueSuffixEnv int, connected bool) string {
+	return fmt.Sprintf(`
+	resource "skytap_environment" "foo" {
+		template_id = "%s"
+		name 		= "%s-environment-%d"
+		description = "This is an environment to support a VPN attachment skytap terraform provider acceptance test"
+	}
+
+	resource "skytap_network" "bar" {
+		name           = "tftest-network-vpn"
+		domain         = "skytap.io"
+		environment_id = skytap_environment.foo.id
+		subnet         = "10.0.9.0/24"
+	}
+
+	data "skytap_vpn" "foo" {
+		name = %q
+	}
+
+	resource "skytap_network_vpn_attachment" "foo" {
+		environment_id = skytap_environment.foo.id
+		network_id     = skytap_network.bar.id
+		vpn_id         = data.skytap_vpn.foo.id
+		connected      = %t
+	}
+`, templateID, networkEnvironmentPrefix, uniqueSuffixEnv, vpnName, connected)
+}
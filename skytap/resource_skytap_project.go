@@ -63,7 +63,7 @@ func resourceSkytapProject() *schema.Resource {
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
-				Description: "A list of environments to add to the project",
+				Description: "A list of environments to add to the project. Environments added to the project outside of this list are ignored",
 			},
 		},
 	}
@@ -157,7 +157,7 @@ func resourceSkytapProjectRead(ctx context.Context, d *schema.ResourceData, meta
 	if err != nil {
 		return diag.Errorf("error retrieving project environments: %v", err)
 	}
-	err = d.Set("environment_ids", flattenProjectEnvironments(environments.Value))
+	err = d.Set("environment_ids", flattenManagedProjectEnvironments(environments.Value, d.Get("environment_ids").(*schema.Set)))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,139 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/skytap/skytap-sdk-go/skytap"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func resourceSkytapProjectEnvironment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSkytapProjectEnvironmentCreate,
+		ReadContext:   resourceSkytapProjectEnvironmentRead,
+		DeleteContext: resourceSkytapProjectEnvironmentDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "ID of the project the environment is added to",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"environment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "ID of the environment to add to the project",
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceSkytapProjectEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).projectsClient
+
+	projectID, err := strconv.Atoi(d.Get("project_id").(string))
+	if err != nil {
+		return diag.Errorf("project (%s) is not an integer: %v", d.Get("project_id").(string), err)
+	}
+	environmentID := d.Get("environment_id").(string)
+
+	log.Printf("[INFO] project environment create: environment (%s) in project (%d)", environmentID, projectID)
+	_, err = client.AddEnvironment(ctx, projectID, environmentID)
+	if err != nil {
+		return diag.Errorf("error adding environment (%s) to project (%d): %v", environmentID, projectID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d/%s", projectID, environmentID))
+
+	log.Printf("[INFO] project environment created: %s", d.Id())
+
+	return resourceSkytapProjectEnvironmentRead(ctx, d, meta)
+}
+
+func resourceSkytapProjectEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).projectsClient
+
+	id := d.Id()
+
+	projectID, err := strconv.Atoi(d.Get("project_id").(string))
+	if err != nil {
+		return diag.Errorf("project (%s) is not an integer: %v", d.Get("project_id").(string), err)
+	}
+	environmentID := d.Get("environment_id").(string)
+
+	log.Printf("[INFO] retrieving project environment: %s", id)
+	environments, err := client.ListEnvironments(ctx, projectID)
+	if err != nil {
+		if utils.ResponseErrorIsNotFound(err) {
+			log.Printf("[DEBUG] project (%d) was not found - removing project environment (%s) from state", projectID, id)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("error retrieving environments of project (%d): %v", projectID, err)
+	}
+
+	if !projectHasEnvironment(environments.Value, environmentID) {
+		log.Printf("[DEBUG] project environment (%s) was not found - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[INFO] project environment retrieved: %s", id)
+
+	return nil
+}
+
+func resourceSkytapProjectEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).projectsClient
+
+	id := d.Id()
+
+	projectID, err := strconv.Atoi(d.Get("project_id").(string))
+	if err != nil {
+		return diag.Errorf("project (%s) is not an integer: %v", d.Get("project_id").(string), err)
+	}
+	environmentID := d.Get("environment_id").(string)
+
+	log.Printf("[INFO] destroying project environment: %s", id)
+	err = client.RemoveEnvironment(ctx, projectID, environmentID)
+	if err != nil {
+		if utils.ResponseErrorIsNotFound(err) {
+			log.Printf("[DEBUG] project environment (%s) was not found - assuming removed", id)
+			return nil
+		}
+
+		return diag.Errorf("error removing environment (%s) from project (%d): %v", environmentID, projectID, err)
+	}
+
+	log.Printf("[INFO] project environment destroyed: %s", id)
+
+	return nil
+}
+
+func projectHasEnvironment(environments []skytap.ProjectEnvironment, environmentID string) bool {
+	for _, v := range environments {
+		if v.ID == environmentID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSkytapProjectEnvironment_Basic(t *testing.T) {
+	templateID, _, _ := setupEnvironment()
+	uniqueSuffix := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSkytapProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The project manages no environments itself and must not remove the association
+				Config: testAccSkytapProjectEnvironmentConfig_basic(templateID, uniqueSuffix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapProjectEnvironmentExists("skytap_project_environment.foo"),
+					resource.TestCheckResourceAttr("skytap_project.foo", "environment_ids.#", "0"),
+				),
+			},
+			{
+				Config:             testAccSkytapProjectEnvironmentConfig_basic(templateID, uniqueSuffix),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+// Verifies the environment is in the project
+func testAccCheckSkytapProjectEnvironmentExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := getResource(s, name)
+		if err != nil {
+			return err
+		}
+
+		// retrieve the connection established in Provider configuration
+		client := testAccProvider.Meta().(*SkytapClient).projectsClient
+		ctx := context.TODO()
+
+		projectID, err := strconv.Atoi(rs.Primary.Attributes["project_id"])
+		if err != nil {
+			return err
+		}
+
+		environments, err := client.ListEnvironments(ctx, projectID)
+		if err != nil {
+			return fmt.Errorf("error retrieving environments of project (%d): %v", projectID, err)
+		}
+
+		if !projectHasEnvironment(environments.Value, rs.Primary.Attributes["environment_id"]) {
+			return fmt.Errorf("project environment (%s) was not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSkytapProjectEnvironmentConfig_basic(envTemplateID string, uniqueSuffix int) string {
+	return fmt.Sprintf(`
+	resource "skytap_environment" "foo" {
+		template_id = "%s"
+		name        = "%s-environment-%d"
+		description = "This is an environment to support a skytap project terraform provider acceptance test"
+	}
+
+	resource "skytap_project" "foo" {
+		name    = "tftest-project-%d"
+		summary = "This is a project created by the skytap terraform provider acceptance test"
+	}
+
+	resource "skytap_project_environment" "foo" {
+		project_id     = skytap_project.foo.id
+		environment_id = skytap_environment.foo.id
+	}
+	`, envTemplateID, vmEnvironmentPrefix, uniqueSuffix, uniqueSuffix)
+}
@@ -0,0 +1,17 @@
+package skytap
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var projectGroupKind = projectMemberKind{
+	name:   "group",
+	list:   ProjectMembersService.ListGroups,
+	add:    ProjectMembersService.AddGroup,
+	update: ProjectMembersService.UpdateGroup,
+	remove: ProjectMembersService.RemoveGroup,
+}
+
+func resourceSkytapProjectGroup() *schema.Resource {
+	return resourceSkytapProjectMember(projectGroupKind)
+}
@@ -0,0 +1,207 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/skytap/skytap-sdk-go/skytap"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+// projectMemberKind describes a kind of project member, a user or a group, and the ProjectMembersService
+// operations which manage it
+type projectMemberKind struct {
+	name   string
+	list   func(ProjectMembersService, context.Context, int) (*ProjectMemberListResult, error)
+	add    func(ProjectMembersService, context.Context, int, string, skytap.ProjectRole) error
+	update func(ProjectMembersService, context.Context, int, string, skytap.ProjectRole) error
+	remove func(ProjectMembersService, context.Context, int, string) error
+}
+
+func (k projectMemberKind) idKey() string {
+	return k.name + "_id"
+}
+
+func resourceSkytapProjectMember(kind projectMemberKind) *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceSkytapProjectMemberCreate(ctx, d, meta, kind)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceSkytapProjectMemberRead(ctx, d, meta, kind)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceSkytapProjectMemberUpdate(ctx, d, meta, kind)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceSkytapProjectMemberDelete(ctx, d, meta, kind)
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  fmt.Sprintf("ID of the project the %s is a member of", kind.name),
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			kind.idKey(): {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  fmt.Sprintf("ID of the %s", kind.name),
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  fmt.Sprintf("The project role of the %s: `viewer`, `participant`, `editor` or `manager`", kind.name),
+				ValidateFunc: validateRoleType(),
+			},
+		},
+	}
+}
+
+func resourceSkytapProjectMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, kind projectMemberKind) diag.Diagnostics {
+	client := meta.(*SkytapClient).projectMembersClient
+
+	projectID, err := projectMemberProjectID(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	memberID := d.Get(kind.idKey()).(string)
+	role := skytap.ProjectRole(d.Get("role").(string))
+
+	log.Printf("[INFO] project %s create: %s (%s) in project (%d) as %s", kind.name, kind.name, memberID, projectID, role)
+	if err = kind.add(client, ctx, projectID, memberID, role); err != nil {
+		return diag.Errorf("error adding %s (%s) to project (%d): %v", kind.name, memberID, projectID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d/%s", projectID, memberID))
+
+	log.Printf("[INFO] project %s created: %s", kind.name, d.Id())
+
+	return resourceSkytapProjectMemberRead(ctx, d, meta, kind)
+}
+
+func resourceSkytapProjectMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}, kind projectMemberKind) diag.Diagnostics {
+	client := meta.(*SkytapClient).projectMembersClient
+
+	id := d.Id()
+
+	projectID, err := projectMemberProjectID(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	memberID := d.Get(kind.idKey()).(string)
+
+	log.Printf("[INFO] retrieving project %s: %s", kind.name, id)
+	members, err := kind.list(client, ctx, projectID)
+	if err != nil {
+		if utils.ResponseErrorIsNotFound(err) {
+			log.Printf("[DEBUG] project (%d) was not found - removing project %s (%s) from state", projectID, kind.name, id)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("error retrieving %ss of project (%d): %v", kind.name, projectID, err)
+	}
+
+	member := findProjectMember(members.Value, memberID)
+	if member == nil {
+		log.Printf("[DEBUG] project %s (%s) was not found - removing from state", kind.name, id)
+		d.SetId("")
+		return nil
+	}
+
+	if member.Role != nil {
+		err = d.Set("role", string(*member.Role))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[INFO] project %s retrieved: %s", kind.name, id)
+
+	return nil
+}
+
+func resourceSkytapProjectMemberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, kind projectMemberKind) diag.Diagnostics {
+	client := meta.(*SkytapClient).projectMembersClient
+
+	projectID, err := projectMemberProjectID(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	memberID := d.Get(kind.idKey()).(string)
+
+	if d.HasChange("role") {
+		role := skytap.ProjectRole(d.Get("role").(string))
+
+		log.Printf("[INFO] project %s update: %s as %s", kind.name, d.Id(), role)
+		if err = kind.update(client, ctx, projectID, memberID, role); err != nil {
+			return diag.Errorf("error updating role of %s (%s) in project (%d): %v", kind.name, memberID, projectID, err)
+		}
+	}
+
+	return resourceSkytapProjectMemberRead(ctx, d, meta, kind)
+}
+
+func resourceSkytapProjectMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, kind projectMemberKind) diag.Diagnostics {
+	client := meta.(*SkytapClient).projectMembersClient
+
+	id := d.Id()
+
+	projectID, err := projectMemberProjectID(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	memberID := d.Get(kind.idKey()).(string)
+
+	log.Printf("[INFO] destroying project %s: %s", kind.name, id)
+	err = kind.remove(client, ctx, projectID, memberID)
+	if err != nil {
+		if utils.ResponseErrorIsNotFound(err) {
+			log.Printf("[DEBUG] project %s (%s) was not found - assuming removed", kind.name, id)
+			return nil
+		}
+
+		return diag.Errorf("error removing %s (%s) from project (%d): %v", kind.name, memberID, projectID, err)
+	}
+
+	log.Printf("[INFO] project %s destroyed: %s", kind.name, id)
+
+	return nil
+}
+
+func projectMemberProjectID(d *schema.ResourceData) (int, error) {
+	projectID, err := strconv.Atoi(d.Get("project_id").(string))
+	if err != nil {
+		return 0, fmt.Errorf("project (%s) is not an integer: %v", d.Get("project_id").(string), err)
+	}
+	return projectID, nil
+}
+
+func findProjectMember(members []ProjectMember, id string) *ProjectMember {
+	for i, member := range members {
+		if member.ID != nil && *member.ID == id {
+			return &members[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func TestAccSkytapProjectMember_Basic(t *testing.T) {
+	kinds := []struct {
+		kind   projectMemberKind
+		envVar string
+	}{
+		{projectUserKind, "SKYTAP_USER_ID"},
+		{projectGroupKind, "SKYTAP_GROUP_ID"},
+	}
+
+	for _, tc := range kinds {
+		kind := tc.kind
+		memberID := utils.GetEnv(tc.envVar, "1")
+		name := fmt.Sprintf("skytap_project_%s.foo", kind.name)
+
+		t.Run(kind.name, func(t *testing.T) {
+			rInt := acctest.RandInt()
+
+			resource.ParallelTest(t, resource.TestCase{
+				PreCheck:          func() { testAccPreCheck(t) },
+				ProviderFactories: testAccProviders,
+				CheckDestroy:      testAccCheckSkytapProjectDestroy,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccSkytapProjectMemberConfig_basic(kind, rInt, memberID, "viewer"),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckSkytapProjectMemberExists(kind, name, "viewer"),
+							resource.TestCheckResourceAttr(name, kind.idKey(), memberID),
+							resource.TestCheckResourceAttr(name, "role", "viewer"),
+						),
+					},
+					{
+						Config: testAccSkytapProjectMemberConfig_basic(kind, rInt, memberID, "manager"),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckSkytapProjectMemberExists(kind, name, "manager"),
+							resource.TestCheckResourceAttr(name, "role", "manager"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+// Verifies the user or group is a member of the project with the expected role
+func testAccCheckSkytapProjectMemberExists(kind projectMemberKind, name string, role string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := getResource(s, name)
+		if err != nil {
+			return err
+		}
+
+		// retrieve the connection established in Provider configuration
+		client := testAccProvider.Meta().(*SkytapClient).projectMembersClient
+		ctx := context.TODO()
+
+		projectID, err := strconv.Atoi(rs.Primary.Attributes["project_id"])
+		if err != nil {
+			return err
+		}
+
+		members, err := kind.list(client, ctx, projectID)
+		if err != nil {
+			return fmt.Errorf("error retrieving %ss of project (%d): %v", kind.name, projectID, err)
+		}
+
+		member := findProjectMember(members.Value, rs.Primary.Attributes[kind.idKey()])
+		if member == nil {
+			return fmt.Errorf("project %s (%s) was not found", kind.name, rs.Primary.ID)
+		}
+		if member.Role == nil || string(*member.Role) != role {
+			return fmt.Errorf("project %s (%s) does not have the %s role", kind.name, rs.Primary.ID, role)
+		}
+
+		return nil
+	}
+}
+
+func testAccSkytapProjectMemberConfig_basic(kind projectMemberKind, rInt int, memberID string, role string) string {
+	return fmt.Sprintf(`
+      resource "skytap_project" "foo" {
+        name    = "tftest-project-%d"
+        summary = "This is a project created by the skytap terraform provider acceptance test"
+      }
+
+      resource "skytap_project_%s" "foo" {
+        project_id = skytap_project.foo.id
+        %-10s = "%s"
+        role       = "%s"
+      }`, rInt, kind.name, kind.idKey(), memberID, role)
+}
@@ -0,0 +1,17 @@
+package skytap
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var projectUserKind = projectMemberKind{
+	name:   "user",
+	list:   ProjectMembersService.ListUsers,
+	add:    ProjectMembersService.AddUser,
+	update: ProjectMembersService.UpdateUser,
+	remove: ProjectMembersService.RemoveUser,
+}
+
+func resourceSkytapProjectUser() *schema.Resource {
+	return resourceSkytapProjectMember(projectUserKind)
+}
@@ -0,0 +1,276 @@
+package skytap
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func resourceSkytapSchedule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSkytapScheduleCreate,
+		ReadContext:   resourceSkytapScheduleRead,
+		UpdateContext: resourceSkytapScheduleUpdate,
+		DeleteContext: resourceSkytapScheduleDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "ID of the environment the schedule runs its actions on",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"title": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "User-defined title of the schedule",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"time_zone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The time zone the schedule times are expressed in, for example `Pacific Time (US & Canada)`. Defaults to the time zone defined in your user account settings",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"start_at": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The date and time the schedule starts. Format: yyyy/mm/dd hh:mm:ss",
+				ValidateFunc: validateDateTime(),
+			},
+
+			"end_at": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The date and time the schedule ends. Format: yyyy/mm/dd hh:mm:ss. If not set, the schedule runs indefinitely",
+				ValidateFunc: validateDateTime(),
+			},
+
+			"delete_at_end": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to `true`, the environment is deleted when the schedule ends",
+			},
+
+			"recurring_days": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Days of the week the actions are run on: `su`, `mo`, `tu`, `we`, `th`, `fr` or `sa`. If not set, the actions are run once, on the start date",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateScheduleRecurringDay(),
+				},
+			},
+
+			"action": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "Set of actions run by the schedule",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The action run on the environment: `run`, `suspend`, `shutdown` or `poweroff`",
+							ValidateFunc: validateScheduleActionType(),
+						},
+						"offset": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  "Minutes after the start time of each scheduled day when the action is run",
+							ValidateFunc: validation.IntBetween(0, 24*60-1),
+						},
+					},
+				},
+			},
+
+			"next_run": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the next scheduled action",
+			},
+
+			"last_run": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the last scheduled action",
+			},
+		},
+	}
+}
+
+func resourceSkytapScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).schedulesClient
+
+	opts := buildSchedule(d)
+	opts.EnvironmentID = utils.String(d.Get("environment_id").(string))
+
+	log.Printf("[INFO] schedule create")
+	log.Printf("[TRACE] schedule create options: %v", spew.Sdump(opts))
+	schedule, err := client.Create(ctx, opts)
+	if err != nil {
+		return diag.Errorf("error creating schedule: %v", err)
+	}
+
+	if schedule.ID == nil {
+		return diag.Errorf("schedule ID is not set")
+	}
+	d.SetId(*schedule.ID)
+
+	log.Printf("[INFO] schedule created: %s", *schedule.ID)
+	log.Printf("[TRACE] schedule created: %v", spew.Sdump(schedule))
+
+	return resourceSkytapScheduleRead(ctx, d, meta)
+}
+
+func resourceSkytapScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).schedulesClient
+
+	id := d.Id()
+
+	log.Printf("[INFO] retrieving schedule: %s", id)
+	schedule, err := client.Get(ctx, id)
+	if err != nil {
+		if utils.ResponseErrorIsNotFound(err) {
+			log.Printf("[DEBUG] schedule (%s) was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("error retrieving schedule (%s): %v", id, err)
+	}
+
+	err = d.Set("environment_id", schedule.EnvironmentID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("title", schedule.Title)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("time_zone", schedule.TimeZone)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("start_at", schedule.StartAt)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("end_at", schedule.EndAt)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("delete_at_end", schedule.DeleteAtEnd)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("recurring_days", schedule.RecurringDays)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("action", flattenScheduleActions(schedule.Actions))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("next_run", schedule.NextRun)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("last_run", schedule.LastRun)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] schedule retrieved: %s", id)
+	log.Printf("[TRACE] schedule retrieved: %v", spew.Sdump(schedule))
+
+	return nil
+}
+
+func resourceSkytapScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).schedulesClient
+
+	id := d.Id()
+
+	opts := buildSchedule(d)
+
+	log.Printf("[INFO] schedule update: %s", id)
+	log.Printf("[TRACE] schedule update options: %v", spew.Sdump(opts))
+	schedule, err := client.Update(ctx, id, opts)
+	if err != nil {
+		return diag.Errorf("error updating schedule (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] schedule updated: %s", id)
+	log.Printf("[TRACE] schedule updated: %v", spew.Sdump(schedule))
+
+	return resourceSkytapScheduleRead(ctx, d, meta)
+}
+
+func resourceSkytapScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).schedulesClient
+
+	id := d.Id()
+
+	log.Printf("[INFO] destroying schedule: %s", id)
+	err := client.Delete(ctx, id)
+	if err != nil {
+		if utils.ResponseErrorIsNotFound(err) {
+			log.Printf("[DEBUG] schedule (%s) was not found - assuming removed", id)
+			return nil
+		}
+
+		return diag.Errorf("error deleting schedule (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] schedule destroyed: %s", id)
+
+	return nil
+}
+
+func buildSchedule(d *schema.ResourceData) *Schedule {
+	schedule := Schedule{
+		Title:         utils.String(d.Get("title").(string)),
+		StartAt:       utils.String(d.Get("start_at").(string)),
+		DeleteAtEnd:   utils.Bool(d.Get("delete_at_end").(bool)),
+		RecurringDays: make([]string, 0),
+	}
+	if v, ok := d.GetOk("time_zone"); ok {
+		schedule.TimeZone = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("end_at"); ok {
+		schedule.EndAt = utils.String(v.(string))
+	}
+	for _, v := range d.Get("recurring_days").(*schema.Set).List() {
+		schedule.RecurringDays = append(schedule.RecurringDays, v.(string))
+	}
+	for _, v := range d.Get("action").(*schema.Set).List() {
+		elem := v.(map[string]interface{})
+		actionType := ScheduleActionType(elem["type"].(string))
+		schedule.Actions = append(schedule.Actions, ScheduleAction{
+			Type:   &actionType,
+			Offset: utils.Int(elem["offset"].(int)),
+		})
+	}
+	return &schedule
+}
@@ -0,0 +1,130 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func TestAccSkytapSchedule_Basic(t *testing.T) {
+	templateID := utils.GetEnv("SKYTAP_TEMPLATE_ID", "1478959")
+	uniqueSuffix := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSkytapScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSkytapScheduleConfig_basic(templateID, uniqueSuffix, "suspend"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapScheduleExists("skytap_schedule.foo"),
+					resource.TestCheckResourceAttr("skytap_schedule.foo", "title", fmt.Sprintf("tftest-schedule-%d", uniqueSuffix)),
+					resource.TestCheckResourceAttr("skytap_schedule.foo", "time_zone", "UTC"),
+					resource.TestCheckResourceAttr("skytap_schedule.foo", "start_at", "2030/01/07 08:00:00"),
+					resource.TestCheckResourceAttr("skytap_schedule.foo", "recurring_days.#", "5"),
+					resource.TestCheckResourceAttr("skytap_schedule.foo", "action.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("skytap_schedule.foo", "action.*", map[string]string{
+						"type":   "suspend",
+						"offset": "600",
+					}),
+				),
+			},
+			{
+				Config: testAccSkytapScheduleConfig_basic(templateID, uniqueSuffix, "shutdown"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapScheduleExists("skytap_schedule.foo"),
+					resource.TestCheckTypeSetElemNestedAttrs("skytap_schedule.foo", "action.*", map[string]string{
+						"type":   "shutdown",
+						"offset": "600",
+					}),
+				),
+			},
+		},
+	})
+}
+
+// Verifies the Schedule exists
+func testAccCheckSkytapScheduleExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := getResource(s, name)
+		if err != nil {
+			return err
+		}
+
+		// retrieve the connection established in Provider configuration
+		client := testAccProvider.Meta().(*SkytapClient).schedulesClient
+		ctx := context.TODO()
+
+		_, err = client.Get(ctx, rs.Primary.ID)
+		if err != nil {
+			if utils.ResponseErrorIsNotFound(err) {
+				return fmt.Errorf("schedule (%s) was not found - does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("error retrieving schedule (%s): %v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+// Verifies the Schedule has been destroyed
+func testAccCheckSkytapScheduleDestroy(s *terraform.State) error {
+	// retrieve the connection established in Provider configuration
+	client := testAccProvider.Meta().(*SkytapClient).schedulesClient
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "skytap_schedule" {
+			continue
+		}
+
+		_, err := client.Get(ctx, rs.Primary.ID)
+		if err != nil {
+			if utils.ResponseErrorIsNotFound(err) {
+				return nil
+			}
+
+			return fmt.Errorf("error waiting for schedule (%s) to be destroyed: %s", rs.Primary.ID, err)
+		}
+
+		return fmt.Errorf("schedule still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSkytapScheduleConfig_basic(envTemplateID string, uniqueSuffix int, endOfDayAction string) string {
+	return fmt.Sprintf(`
+	resource "skytap_environment" "foo" {
+		template_id = "%s"
+		name 		= "tftest-schedule-environment-%d"
+		description = "This is an environment to support a skytap schedule terraform provider acceptance test"
+	}
+
+	resource "skytap_schedule" "foo" {
+		environment_id = skytap_environment.foo.id
+		title          = "tftest-schedule-%d"
+		time_zone      = "UTC"
+		start_at       = "2030/01/07 08:00:00"
+		recurring_days = ["mo", "tu", "we", "th", "fr"]
+
+		action {
+			type   = "run"
+			offset = 0
+		}
+
+		action {
+			type   = %q
+			offset = 600
+		}
+	}
+	`, envTemplateID, uniqueSuffix, uniqueSuffix, endOfDayAction)
+}
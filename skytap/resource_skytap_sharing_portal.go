@@ -0,0 +1,256 @@
+package skytap
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func resourceSkytapSharingPortal() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSkytapSharingPortalCreate,
+		ReadContext:   resourceSkytapSharingPortalRead,
+		UpdateContext: resourceSkytapSharingPortalUpdate,
+		DeleteContext: resourceSkytapSharingPortalDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "ID of the environment containing the VMs of the sharing portal",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "User-defined name of the sharing portal",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"vm": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "Set of VMs included in the sharing portal",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vm_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "ID of the VM",
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"access": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The access given to users of the sharing portal: `run_and_use`, `use`, `run_and_view_only` or `view_only`",
+							ValidateFunc: validateSharingPortalAccess(),
+						},
+						"desktop_url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL of the desktop of the VM in the sharing portal",
+						},
+					},
+				},
+			},
+
+			"password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				Description:  "The password users must enter to access the sharing portal. If not set, the portal is not password protected",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"time_zone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The time zone the start and end times are expressed in, for example `Pacific Time (US & Canada)`. Defaults to the time zone defined in your user account settings",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The date and time the sharing portal becomes available. Format: yyyy/mm/dd hh:mm:ss",
+				ValidateFunc: validateDateTime(),
+			},
+
+			"end_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The date and time the sharing portal stops being available. Format: yyyy/mm/dd hh:mm:ss",
+				ValidateFunc: validateDateTime(),
+			},
+
+			"desktops_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the sharing portal",
+			},
+		},
+	}
+}
+
+func resourceSkytapSharingPortalCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).sharingPortalsClient
+
+	environmentID := d.Get("environment_id").(string)
+
+	opts := buildSharingPortal(d)
+	opts.PublishSetType = utils.String(sharingPortalTypeSingleURL)
+
+	// the options are not traced as they may contain the password
+	log.Printf("[INFO] sharing portal create")
+	portal, err := client.Create(ctx, environmentID, opts)
+	if err != nil {
+		return diag.Errorf("error creating sharing portal: %v", err)
+	}
+
+	if portal.ID == nil {
+		return diag.Errorf("sharing portal ID is not set")
+	}
+	d.SetId(*portal.ID)
+
+	log.Printf("[INFO] sharing portal created: %s", *portal.ID)
+
+	return resourceSkytapSharingPortalRead(ctx, d, meta)
+}
+
+func resourceSkytapSharingPortalRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).sharingPortalsClient
+
+	environmentID := d.Get("environment_id").(string)
+	id := d.Id()
+
+	log.Printf("[INFO] retrieving sharing portal: %s", id)
+	portal, err := client.Get(ctx, environmentID, id)
+	if err != nil {
+		if utils.ResponseErrorIsNotFound(err) {
+			log.Printf("[DEBUG] sharing portal (%s) was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("error retrieving sharing portal (%s): %v", id, err)
+	}
+
+	// the password is not read back and traced, the configured value is kept
+	portal.Password = nil
+
+	err = d.Set("name", portal.Name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("vm", flattenSharingPortalVMs(portal.VMs))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("time_zone", portal.TimeZone)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("start_time", portal.StartTime)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("end_time", portal.EndTime)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("desktops_url", portal.DesktopsURL)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] sharing portal retrieved: %s", id)
+	log.Printf("[TRACE] sharing portal retrieved: %v", spew.Sdump(portal))
+
+	return nil
+}
+
+func resourceSkytapSharingPortalUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).sharingPortalsClient
+
+	environmentID := d.Get("environment_id").(string)
+	id := d.Id()
+
+	opts := buildSharingPortal(d)
+
+	log.Printf("[INFO] sharing portal update: %s", id)
+	_, err := client.Update(ctx, environmentID, id, opts)
+	if err != nil {
+		return diag.Errorf("error updating sharing portal (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] sharing portal updated: %s", id)
+
+	return resourceSkytapSharingPortalRead(ctx, d, meta)
+}
+
+func resourceSkytapSharingPortalDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).sharingPortalsClient
+
+	environmentID := d.Get("environment_id").(string)
+	id := d.Id()
+
+	log.Printf("[INFO] destroying sharing portal: %s", id)
+	err := client.Delete(ctx, environmentID, id)
+	if err != nil {
+		if utils.ResponseErrorIsNotFound(err) {
+			log.Printf("[DEBUG] sharing portal (%s) was not found - assuming removed", id)
+			return nil
+		}
+
+		return diag.Errorf("error deleting sharing portal (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] sharing portal destroyed: %s", id)
+
+	return nil
+}
+
+func buildSharingPortal(d *schema.ResourceData) *SharingPortal {
+	portal := SharingPortal{
+		Name: utils.String(d.Get("name").(string)),
+		VMs:  make([]SharingPortalVM, 0),
+	}
+	if v, ok := d.GetOk("password"); ok {
+		portal.Password = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("time_zone"); ok {
+		portal.TimeZone = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("start_time"); ok {
+		portal.StartTime = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("end_time"); ok {
+		portal.EndTime = utils.String(v.(string))
+	}
+	for _, v := range d.Get("vm").(*schema.Set).List() {
+		elem := v.(map[string]interface{})
+		access := SharingPortalAccess(elem["access"].(string))
+		portal.VMs = append(portal.VMs, SharingPortalVM{
+			VMID:   utils.String(elem["vm_id"].(string)),
+			Access: &access,
+		})
+	}
+	return &portal
+}
@@ -0,0 +1,77 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSkytapSharingPortal_Basic(t *testing.T) {
+	templateID, vmID, newEnvTemplateID := setupEnvironment()
+	uniqueSuffixEnv := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSkytapEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSkytapSharingPortalConfig_basic(newEnvTemplateID, templateID, vmID, uniqueSuffixEnv, "run_and_use"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapSharingPortalExists("skytap_sharing_portal.portal"),
+					resource.TestCheckResourceAttr("skytap_sharing_portal.portal", "name", "training"),
+					resource.TestCheckResourceAttr("skytap_sharing_portal.portal", "vm.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("skytap_sharing_portal.portal", "vm.*", map[string]string{"access": "run_and_use"}),
+					resource.TestCheckResourceAttrSet("skytap_sharing_portal.portal", "desktops_url"),
+				),
+			},
+			{
+				Config: testAccSkytapSharingPortalConfig_basic(newEnvTemplateID, templateID, vmID, uniqueSuffixEnv, "view_only"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapSharingPortalExists("skytap_sharing_portal.portal"),
+					resource.TestCheckTypeSetElemNestedAttrs("skytap_sharing_portal.portal", "vm.*", map[string]string{"access": "view_only"}),
+				),
+			},
+		},
+	})
+}
+
+// Verifies the sharing portal exists
+func testAccCheckSkytapSharingPortalExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := getResource(s, name)
+		if err != nil {
+			return err
+		}
+
+		// retrieve the connection established in Provider configuration
+		client := testAccProvider.Meta().(*SkytapClient).sharingPortalsClient
+		ctx := context.TODO()
+
+		_, err = client.Get(ctx, rs.Primary.Attributes["environment_id"], rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error retrieving sharing portal (%s): %v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccSkytapSharingPortalConfig_basic(envTemplateID string, templateID string, vmID string, uniqueSuffixEnv int, access string) string {
+	return testAccSkytapVMConfig_typical(envTemplateID, templateID, vmID, uniqueSuffixEnv, 8080, "", "") + fmt.Sprintf(`
+
+    resource "skytap_sharing_portal" "portal" {
+      environment_id = skytap_environment.my_new_environment.id
+      name           = "training"
+      password       = "tftest-password"
+
+      vm {
+        vm_id  = skytap_vm.cassandra1.id
+        access = "%s"
+      }
+    }`, access)
+}
@@ -0,0 +1,399 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func resourceSkytapTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSkytapTemplateCreate,
+		ReadContext:   resourceSkytapTemplateRead,
+		UpdateContext: resourceSkytapTemplateUpdate,
+		DeleteContext: resourceSkytapTemplateDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "ID of the environment you want to save as a template. If updated with a new ID, the template will be recreated",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "User-defined name of the template. Limited to 255 characters. UTF-8 character type",
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "User-defined description of the template. Limited to 1000 characters. UTF-8 character type. The description copied from the environment is cleared when not set",
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+
+			"tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Set of template tags",
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					DiffSuppressFunc: caseInsensitiveSuppress,
+				},
+				Set: stringCaseSensitiveHash,
+			},
+
+			"project_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Set of IDs of the projects the template is shared with",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+
+			"vm_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of VMs in the template",
+			},
+
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The region where the template is stored",
+			},
+		},
+	}
+}
+
+func resourceSkytapTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).templateManagementClient
+
+	environmentID := d.Get("environment_id").(string)
+
+	// The environment cannot be saved while it is changing state.
+	if err := waitForEnvironmentReady(ctx, d, meta, environmentID, schema.TimeoutCreate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := CreateTemplateRequest{
+		EnvironmentID: &environmentID,
+	}
+
+	log.Printf("[INFO] template create")
+	log.Printf("[TRACE] template create options: %v", spew.Sdump(opts))
+	template, err := client.Create(ctx, &opts)
+	if err != nil {
+		return diag.Errorf("error creating template from environment (%s): %v", environmentID, err)
+	}
+
+	if template.ID == nil {
+		return diag.Errorf("template ID is not set")
+	}
+	d.SetId(*template.ID)
+
+	log.Printf("[INFO] template created: %s", *template.ID)
+	log.Printf("[TRACE] template created: %v", spew.Sdump(template))
+
+	if err = waitForTemplateReady(ctx, d, meta, schema.TimeoutCreate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	updateOpts := UpdateTemplateRequest{
+		Name:        &name,
+		Description: utils.String(d.Get("description").(string)),
+	}
+
+	log.Printf("[INFO] template update after create: %s", d.Id())
+	log.Printf("[TRACE] template update options: %v", spew.Sdump(updateOpts))
+	if _, err = client.Update(ctx, d.Id(), &updateOpts); err != nil {
+		return diag.Errorf("error updating template (%s): %v", d.Id(), err)
+	}
+
+	if tag, ok := d.GetOk("tags"); ok {
+		if err = client.CreateTags(ctx, d.Id(), environmentCreateTags(tag.(*schema.Set))); err != nil {
+			return diag.Errorf("error adding tags to template (%s): %v", d.Id(), err)
+		}
+	}
+
+	for _, projectID := range d.Get("project_ids").(*schema.Set).List() {
+		if err = addTemplateToProject(ctx, meta, d.Id(), projectID.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceSkytapTemplateRead(ctx, d, meta)
+}
+
+func resourceSkytapTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).templatesClient
+
+	id := d.Id()
+
+	log.Printf("[INFO] retrieving template: %s", id)
+	template, err := client.Get(ctx, id)
+	if err != nil {
+		if utils.ResponseErrorIsNotFound(err) {
+			log.Printf("[DEBUG] template (%s) was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("error retrieving template (%s): %v", id, err)
+	}
+
+	// The environmentID is not set as it is only used to build the template and is not returned by the template response.
+	err = d.Set("name", template.Name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("description", template.Description)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("vm_count", template.VMCount)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("region", template.Region)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tags", flattenTags(template.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	projects, err := meta.(*SkytapClient).templateManagementClient.ListProjects(ctx, id)
+	if err != nil {
+		return diag.Errorf("error retrieving template projects: %v", err)
+	}
+	if err = d.Set("project_ids", flattenProjectIDs(projects.Value)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] template retrieved: %s", id)
+	log.Printf("[TRACE] template retrieved: %v", spew.Sdump(template))
+
+	return nil
+}
+
+func resourceSkytapTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).templateManagementClient
+
+	id := d.Id()
+
+	if d.HasChanges("name", "description") {
+		name := d.Get("name").(string)
+		opts := UpdateTemplateRequest{
+			Name:        &name,
+			Description: utils.String(d.Get("description").(string)),
+		}
+
+		log.Printf("[INFO] template update: %s", id)
+		log.Printf("[TRACE] template update options: %v", spew.Sdump(opts))
+		template, err := client.Update(ctx, id, &opts)
+		if err != nil {
+			return diag.Errorf("error updating template (%s): %v", id, err)
+		}
+		log.Printf("[INFO] template updated: %s", id)
+		log.Printf("[TRACE] template updated: %v", spew.Sdump(template))
+	}
+
+	if d.HasChange("tags") {
+		old, new := d.GetChange("tags")
+		tagsToRemove := old.(*schema.Set).Difference(new.(*schema.Set))
+		tagsToAdd := new.(*schema.Set).Difference(old.(*schema.Set))
+
+		if tagsToRemove.Len() > 0 {
+			template, err := meta.(*SkytapClient).templatesClient.Get(ctx, id)
+			if err != nil {
+				return diag.Errorf("error retrieving template (%s): %v", id, err)
+			}
+			// Create a dictionary to transform tags in ids
+			tagDictionary := make(map[string]string)
+			for _, t := range template.Tags {
+				tagDictionary[*t.Value] = *t.ID
+			}
+			// No batch removal supported by the api, remove one by one
+			for _, t := range tagsToRemove.List() {
+				if err := client.DeleteTag(ctx, id, tagDictionary[t.(string)]); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+
+		if err := client.CreateTags(ctx, id, environmentCreateTags(tagsToAdd)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("project_ids") {
+		old, new := d.GetChange("project_ids")
+		for _, projectID := range old.(*schema.Set).Difference(new.(*schema.Set)).List() {
+			if err := removeTemplateFromProject(ctx, meta, id, projectID.(string)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		for _, projectID := range new.(*schema.Set).Difference(old.(*schema.Set)).List() {
+			if err := addTemplateToProject(ctx, meta, id, projectID.(string)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceSkytapTemplateRead(ctx, d, meta)
+}
+
+func resourceSkytapTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).templateManagementClient
+
+	id := d.Id()
+
+	if err := waitForTemplateReady(ctx, d, meta, schema.TimeoutDelete); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] destroying template: %s", id)
+	err := client.Delete(ctx, id)
+	if err != nil {
+		if utils.ResponseErrorIsNotFound(err) {
+			log.Printf("[DEBUG] template (%s) was not found - assuming removed", id)
+			return nil
+		}
+
+		return diag.Errorf("error deleting template (%s): %v", id, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"false"},
+		Target:     []string{"true"},
+		Refresh:    templateDeleteRefreshFunc(ctx, d, meta),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: minTimeout * time.Second,
+		Delay:      delay * time.Second,
+	}
+
+	log.Printf("[INFO] Waiting for template (%s) to be removed", id)
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for template (%s) to be removed: %s", id, err)
+	}
+
+	log.Printf("[INFO] template destroyed: %s", id)
+
+	return nil
+}
+
+// waitForTemplateReady waits until the template is no longer busy, e.g. while the environment is being copied into it.
+func waitForTemplateReady(ctx context.Context, d *schema.ResourceData, meta interface{}, schemaTimeout string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"true"},
+		Target:     []string{"false"},
+		Refresh:    templateBusyRefreshFunc(ctx, d, meta),
+		Timeout:    d.Timeout(schemaTimeout),
+		MinTimeout: minTimeout * time.Second,
+		Delay:      delay * time.Second,
+	}
+
+	log.Printf("[INFO] Waiting for template (%s) to complete", d.Id())
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for template (%s) to complete: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func templateBusyRefreshFunc(
+	ctx context.Context, d *schema.ResourceData, meta interface{}) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		client := meta.(*SkytapClient).templatesClient
+
+		id := d.Id()
+
+		log.Printf("[DEBUG] retrieving template: %s", id)
+		template, err := client.Get(ctx, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("error retrieving template (%s) when waiting: %v", id, err)
+		}
+
+		busy := template.Busy != nil && *template.Busy
+		log.Printf("[DEBUG] template busy (%s): %t", id, busy)
+
+		return template, strconv.FormatBool(busy), nil
+	}
+}
+
+func templateDeleteRefreshFunc(
+	ctx context.Context, d *schema.ResourceData, meta interface{}) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		client := meta.(*SkytapClient).templatesClient
+
+		id := d.Id()
+
+		log.Printf("[DEBUG] retrieving template: %s", id)
+		template, err := client.Get(ctx, id)
+
+		var removed = "false"
+		if err != nil {
+			if utils.ResponseErrorIsNotFound(err) {
+				log.Printf("[DEBUG] template (%s) has been removed.", id)
+				removed = "true"
+			} else {
+				return nil, "", fmt.Errorf("error retrieving template (%s) when waiting: %v", id, err)
+			}
+		}
+
+		return template, removed, nil
+	}
+}
+
+func addTemplateToProject(ctx context.Context, meta interface{}, id string, projectID string) error {
+	client := meta.(*SkytapClient).templateManagementClient
+
+	pid, err := strconv.Atoi(projectID)
+	if err != nil {
+		return fmt.Errorf("project (%s) is not an integer: %v", projectID, err)
+	}
+	log.Printf("[INFO] adding template (%s) to project (%d)", id, pid)
+	if err = client.AddToProject(ctx, id, pid); err != nil {
+		return fmt.Errorf("error adding template (%s) to project (%d): %v", id, pid, err)
+	}
+	return nil
+}
+
+func removeTemplateFromProject(ctx context.Context, meta interface{}, id string, projectID string) error {
+	client := meta.(*SkytapClient).templateManagementClient
+
+	pid, err := strconv.Atoi(projectID)
+	if err != nil {
+		return fmt.Errorf("project (%s) is not an integer: %v", projectID, err)
+	}
+	log.Printf("[INFO] removing template (%s) from project (%d)", id, pid)
+	if err = client.RemoveFromProject(ctx, id, pid); err != nil && !utils.ResponseErrorIsNotFound(err) {
+		return fmt.Errorf("error removing template (%s) from project (%d): %v", id, pid, err)
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func init() {
+	resource.AddTestSweepers("skytap_template", &resource.Sweeper{
+		Name: "skytap_template",
+		F:    testSweepSkytapTemplate,
+	})
+}
+
+func testSweepSkytapTemplate(region string) error {
+	meta, err := sharedClientForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.TODO()
+
+	log.Printf("[INFO] Retrieving list of templates")
+	templates, err := meta.templatesClient.List(ctx)
+	if err != nil {
+		return fmt.Errorf("error retrieving list of templates: %v", err)
+	}
+
+	for _, t := range templates.Value {
+		if shouldSweepAcceptanceTestResource(*t.Name) {
+			log.Printf("destroying template %s", *t.Name)
+			if err := meta.templateManagementClient.Delete(ctx, *t.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func TestAccSkytapTemplate_Basic(t *testing.T) {
+	templateID, _, _ := setupEnvironment()
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSkytapTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSkytapTemplateConfig_basic(templateID, rInt, "first", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapTemplateExists("skytap_template.foo"),
+					resource.TestCheckResourceAttr("skytap_template.foo", "name", fmt.Sprintf("tftest-template-%d", rInt)),
+					resource.TestCheckResourceAttr("skytap_template.foo", "description", "first"),
+					resource.TestCheckResourceAttrSet("skytap_template.foo", "vm_count"),
+					resource.TestCheckResourceAttrSet("skytap_template.foo", "region"),
+					resource.TestCheckResourceAttr("skytap_template.foo", "tags.#", "2"),
+					resource.TestCheckResourceAttr("skytap_template.foo", "project_ids.#", "0"),
+				),
+			},
+			{
+				Config: testAccSkytapTemplateConfig_basic(templateID, rInt, "second", "skytap_project.foo.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapTemplateExists("skytap_template.foo"),
+					resource.TestCheckResourceAttr("skytap_template.foo", "description", "second"),
+					resource.TestCheckTypeSetElemAttrPair("skytap_template.foo", "project_ids.*", "skytap_project.foo", "id"),
+				),
+			},
+			{
+				// removing the description clears it
+				Config: testAccSkytapTemplateConfig_basic(templateID, rInt, "", "skytap_project.foo.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapTemplateExists("skytap_template.foo"),
+					resource.TestCheckResourceAttr("skytap_template.foo", "description", ""),
+				),
+			},
+		},
+	})
+}
+
+// Verifies the Template exists
+func testAccCheckSkytapTemplateExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := getResource(s, name)
+		if err != nil {
+			return err
+		}
+
+		// retrieve the connection established in Provider configuration
+		client := testAccProvider.Meta().(*SkytapClient).templatesClient
+		ctx := context.TODO()
+
+		_, err = client.Get(ctx, rs.Primary.ID)
+		if err != nil {
+			if utils.ResponseErrorIsNotFound(err) {
+				return fmt.Errorf("template (%s) was not found - does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("error retrieving template (%s): %v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+// Verifies the Template has been destroyed
+func testAccCheckSkytapTemplateDestroy(s *terraform.State) error {
+	// retrieve the connection established in Provider configuration
+	client := testAccProvider.Meta().(*SkytapClient).templatesClient
+	ctx := context.TODO()
+
+	// loop through the resources in state, verifying each template
+	// is destroyed
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "skytap_template" {
+			continue
+		}
+
+		_, err := client.Get(ctx, rs.Primary.ID)
+		if err != nil {
+			if utils.ResponseErrorIsNotFound(err) {
+				return nil
+			}
+
+			return fmt.Errorf("error waiting for template (%s) to be destroyed: %s", rs.Primary.ID, err)
+		}
+
+		return fmt.Errorf("template still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSkytapTemplateConfig_basic(envTemplateID string, uniqueSuffix int, description string, projectIDs string) string {
+	if description != "" {
+		description = fmt.Sprintf("description    = %q", description)
+	}
+	return fmt.Sprintf(`
+	resource "skytap_environment" "foo" {
+ 		template_id = "%s"
+ 		name 		= "tftest-template-environment-%d"
+ 		description = "This is an environment to support a skytap template terraform provider acceptance test"
+ 	}
+	resource "skytap_project" "foo" {
+	    name = "tftest-template-project-%d"
+	    summary = "This is a project created by the skytap terraform provider acceptance test"
+	}
+	resource "skytap_template" "foo" {
+		environment_id = skytap_environment.foo.id
+		name           = "tftest-template-%d"
+		%s
+		tags           = ["golden", "terraform"]
+		project_ids    = [%s]
+	}
+	`, envTemplateID, uniqueSuffix, uniqueSuffix, uniqueSuffix, description, projectIDs)
+}
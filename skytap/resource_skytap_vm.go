@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
@@ -17,11 +18,13 @@ import (
 )
 
 func resourceSkytapVM() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		CreateContext: resourceSkytapVMCreate,
 		ReadContext:   resourceSkytapVMRead,
 		UpdateContext: resourceSkytapVMUpdate,
 		DeleteContext: resourceSkytapVMDelete,
+		CustomizeDiff: resourceSkytapVMCustomizeDiff,
+		SchemaVersion: 1,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
@@ -40,17 +43,27 @@ func resourceSkytapVM() *schema.Resource {
 
 			"template_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
 				ForceNew:     true,
 				Description:  "ID of the template you want to create the VM from",
 				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"template_id", "source_environment_id"},
+			},
+
+			"source_environment_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "ID of the environment you want to copy the VM from",
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"template_id", "source_environment_id"},
 			},
 
 			"vm_id": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				Description:  "ID of the VM within the template that you want to create the VM from",
+				Description:  "ID of the VM within the template or the source environment that you want to create the VM from",
 				ValidateFunc: validation.NoZeroValues,
 			},
 
@@ -98,10 +111,103 @@ func resourceSkytapVM() *schema.Resource {
 				ValidateFunc: validation.IntBetween(2048, 2096128),
 			},
 
+			"cpus_per_socket": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Number of CPUs per socket. The number of CPUs must be a multiple of this value",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"nested_virtualization": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the CPU virtualization extensions are exposed to the guest OS, allowing it to run its own hypervisor",
+			},
+
+			"time_sync_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the guest OS clock is synchronized with the host",
+			},
+
+			"copy_paste_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether copy and paste between the VM console and the local computer is enabled",
+			},
+
+			"vnc_keymap": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The keyboard layout of the VM console (for example, `en-us`)",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"rtc_start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The date and time the VM clock is set to when the VM starts. Format: yyyy/mm/dd hh:mm:ss",
+				ValidateFunc: validateDateTime(),
+			},
+
+			"guest_os": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The guest OS type of the VM, as defined by the hypervisor (for example, `ubuntu-64`)",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"hardware_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The hardware version of the VM. The VM is stopped and upgraded when the version is increased; it cannot be downgraded",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"power_cycle_attributes": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The attributes of the planned update that require the VM to be stopped and restarted. It is cleared by the next refresh",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"max_hardware_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Maximum hardware version the VM can be upgraded to",
+			},
+
+			"hardware_upgradable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the hardware version of the VM can be upgraded",
+			},
+
+			"architecture": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The architecture of the VM, `x86` or `power`",
+			},
+
+			"instance_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The instance type of an IBM Power VM",
+			},
+
 			"disk": {
 				Type:        schema.TypeSet,
 				Optional:    true,
 				Description: "Set of virtual disks within the VM",
+				Set:         diskHash,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -121,14 +227,15 @@ func resourceSkytapVM() *schema.Resource {
 							Computed: true,
 						},
 						"type": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "The type of disk",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The type of the disk controller, `SCSI`, `SATA` or `IDE`. It can only be chosen when the disk is added, and is only kept in the state when configured",
+							ValidateFunc: validation.StringInSlice([]string{"SCSI", "SATA", "IDE"}, false),
 						},
 						"controller": {
 							Type:        schema.TypeString,
 							Computed:    true,
-							Description: "The disk controller",
+							Description: "The number of the disk controller",
 						},
 						"lun": {
 							Type:        schema.TypeString,
@@ -178,6 +285,45 @@ func resourceSkytapVM() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"mac": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The MAC address of the network adapter",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the network adapter",
+						},
+						"network_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the network that this network adapter is attached to",
+						},
+						"network_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the network that this network adapter is attached to",
+						},
+						"public_ip": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Public IP addresses attached to the network adapter",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The public IP address",
+									},
+									"dns_name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The DNS name of the public IP address",
+									},
+								},
+							},
+						},
 
 						"published_service": {
 							Type:        schema.TypeSet,
@@ -229,6 +375,29 @@ func resourceSkytapVM() *schema.Resource {
 				Description: "Map of external IP addresses. The key is the name of a published service - as defined in the `published_service` block",
 				Elem:        &schema.Schema{Type: schema.TypeInt},
 			},
+			"allow_stop_for_update": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If false, a plan with changes which require the running VM to be stopped is rejected",
+			},
+
+			"stop_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      vmStopMethodShutdown,
+				Description:  "How the VM is stopped before an update which requires it: `shutdown` shuts the guest OS down, `halted` powers the VM off",
+				ValidateFunc: validation.StringInSlice([]string{vmStopMethodShutdown, vmStopMethodHalted}, false),
+			},
+
+			"shutdown_timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				Description:  "Number of seconds to wait for the guest OS to shut down before the VM is powered off",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
 			"user_data": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -260,6 +429,27 @@ func resourceSkytapVM() *schema.Resource {
 			},
 		},
 	}
+	// the state of a version 0 VM is read with the current schema, which only adds attributes to it
+	r.StateUpgraders = []schema.StateUpgrader{
+		{
+			Version: 0,
+			Type:    r.CoreConfigSchema().ImpliedType(),
+			Upgrade: resourceSkytapVMStateUpgradeV0,
+		},
+	}
+	return r
+}
+
+// resourceSkytapVMStateUpgradeV0 blanks the disk types, which were computed before they could be configured
+func resourceSkytapVMStateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	if disks, ok := rawState["disk"].([]interface{}); ok {
+		for _, disk := range disks {
+			if diskMap, ok := disk.(map[string]interface{}); ok {
+				diskMap["type"] = ""
+			}
+		}
+	}
+	return rawState, nil
 }
 
 func resourceSkytapVMCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -297,13 +487,19 @@ func resourceSkytapVMCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
-	vmDisks, err := addVMHardware(ctx, d, meta, environmentID, id)
-	if err != nil {
+	if err = addVMHardware(ctx, d, meta, environmentID, id); err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := d.Set("disk", vmDisks); err != nil {
-		log.Printf("[ERROR] error flattening disks: %v", err)
+	if err = updateVMDisks(ctx, d, meta, environmentID, id, schema.TimeoutCreate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = updateVMHardwareSettings(ctx, d, meta, environmentID, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = upgradeVMHardwareVersion(ctx, d, meta, environmentID, id); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -362,7 +558,7 @@ func resourceSkytapVMRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.Errorf("error retrieving VM (%s): %v", id, err)
 	}
 
-	// templateID and vmID are not set, as they are not returned by the VM response.
+	// templateID, sourceEnvironmentID and vmID are not set, as they are not returned by the VM response.
 	// If any of these attributes are changed, this VM will be rebuilt.
 	err = d.Set("environment_id", environmentID)
 	if err != nil {
@@ -441,8 +637,39 @@ func resourceSkytapVMRead(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
-	if len(vm.Hardware.Disks) > 0 {
-		err = d.Set("os_disk_size", *vm.Hardware.Disks[0].Size)
+	if err = setVMHardwareSettings(d, vm.Hardware); err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("hardware_version", vm.HardwareVersion)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("max_hardware_version", vm.MaxHardwareVersion)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// the power cycle attributes only describe a plan, so they are cleared once it is applied
+	err = d.Set("power_cycle_attributes", []string{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("hardware_upgradable", vm.Hardware.Upgradable)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("architecture", vm.Hardware.Architecture)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("instance_type", vm.Hardware.InstanceType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the disks of an IBM Power VM are not managed
+	power := isPowerArchitecture(vm.Hardware)
+	if osDisk := osDiskIndex(vm.Hardware.Disks); osDisk >= 0 && !power {
+		err = d.Set("os_disk_size", *vm.Hardware.Disks[osDisk].Size)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -451,7 +678,7 @@ func resourceSkytapVMRead(ctx context.Context, d *schema.ResourceData, meta inte
 	for _, disk := range vm.Hardware.Disks {
 		log.Printf("[INFO] disks: %#v, %#v", disk.Name, disk.Size)
 	}
-	if len(vm.Hardware.Disks) > 1 {
+	if len(vm.Hardware.Disks) > 1 && !power {
 		// add the names
 
 		diskSet := d.Get("disk").(*schema.Set)
@@ -464,7 +691,7 @@ func resourceSkytapVMRead(ctx context.Context, d *schema.ResourceData, meta inte
 				}
 			}
 		}
-		diskSetFlattened := flattenDisks(vm.Hardware.Disks)
+		diskSetFlattened := clearDiskTypes(flattenDisks(vm.Hardware.Disks), configuredDiskTypes(diskSet))
 
 		if err := d.Set("disk", diskSetFlattened); err != nil {
 			log.Printf("[ERROR] error flattening disks: %v", err)
@@ -478,89 +705,62 @@ func resourceSkytapVMRead(ctx context.Context, d *schema.ResourceData, meta inte
 }
 
 func resourceSkytapVMUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*SkytapClient).vmsClient
 	interfacesClient := meta.(*SkytapClient).interfacesClient
 
 	environmentID := d.Get("environment_id").(string)
 	id := d.Id()
 
-	opts := skytap.UpdateVMRequest{}
+	powerCycle := vmPowerCycleChanges(d, vmPowerCycleKeys)
+	vm, err := beginVMUpdate(ctx, d, meta, environmentID, id, schema.TimeoutUpdate, powerCycle)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	if v, ok := d.GetOk("name"); ok && d.HasChange("name") {
-		opts.Name = utils.String(v.(string))
+	// the settings which do not need a power-off are applied while the VM is running, unless it is stopped anyway
+	if d.HasChanges(vmHardwareSettingsKeys...) && !d.HasChanges(vmStoppedHardwareSettingsKeys...) {
+		if err = updateVMHardwareSettings(ctx, d, meta, environmentID, id); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
-	env, err := client.Get(ctx, environmentID, id)
-	if err != nil {
-		return diag.FromErr(err)
+	if !d.HasChanges(vmPowerCycleKeys...) {
+		return readVMAfterUpdate(ctx, d, meta, powerCycle)
 	}
 
 	// Previous state to start
-	previousState := env.Runstate
+	previousState := vm.Runstate
 
-	hardware, err := updateHardware(d)
-	if err != nil {
+	if err = updateVMSizing(ctx, d, meta, environmentID, id, vm, schema.TimeoutUpdate); err != nil {
 		return diag.FromErr(err)
 	}
-	opts.Hardware = hardware
-
-	var vmDisks []interface{}
-	if d.HasChange("disk") || d.HasChange("name") || d.HasChange("ram") ||
-		d.HasChange("cpus") || d.HasChange("os_disk_size") {
 
-		if err = forceRunstate(ctx, meta, environmentID, id, skytap.VMRunstateStopped); err != nil {
+	if d.HasChanges("disk", "os_disk_size") {
+		if err = stopVM(ctx, d, meta, environmentID, id, schema.TimeoutUpdate); err != nil {
 			return diag.FromErr(err)
 		}
 
-		log.Printf("[INFO] VM update: %s", id)
-		log.Printf("[TRACE] VM update options: %v", spew.Sdump(opts))
-		vm, err := client.Update(ctx, environmentID, id, &opts)
-		if err != nil {
-			return diag.Errorf("error updating vm (%s): %v", id, err)
-		}
-
-		log.Printf("[INFO] updated VM: %s", id)
-		log.Printf("[TRACE] updated VM: %v", spew.Sdump(vm))
-
-		// Have to do this here in order to capture `name`
-		vmDisks = flattenDisks(vm.Hardware.Disks)
-
-		if err := d.Set("disk", vmDisks); err != nil {
+		if err = updateVMDisks(ctx, d, meta, environmentID, id, schema.TimeoutUpdate); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
-	if d.HasChange("user_data") {
-		if userData, ok := d.GetOk("user_data"); ok {
-			if err := client.UpdateUserData(ctx, environmentID, id, utils.String(userData.(string))); err != nil {
-				return diag.FromErr(err)
-			}
+	if d.HasChanges(vmStoppedHardwareSettingsKeys...) {
+		if err = stopVM(ctx, d, meta, environmentID, id, schema.TimeoutUpdate); err != nil {
+			return diag.FromErr(err)
 		}
-	}
-
-	if d.HasChange("label") {
-		old, new := d.GetChange("label")
-		remove := old.(*schema.Set).Difference(new.(*schema.Set))
-		add := new.(*schema.Set).Difference(old.(*schema.Set))
 
-		for _, l := range remove.List() {
-			label := l.(map[string]interface{})
-			if err = client.DeleteLabel(ctx, environmentID, id, label["id"].(string)); err != nil {
-				return diag.FromErr(err)
-			}
+		if err = updateVMHardwareSettings(ctx, d, meta, environmentID, id); err != nil {
+			return diag.FromErr(err)
 		}
-		labelsToAdd := vmCreateLabels(add)
+	}
 
-		for _, l := range labelsToAdd {
-			if err = client.CreateLabel(ctx, environmentID, id, l); err != nil {
-				return diag.FromErr(err)
-			}
-		}
+	if err = upgradeVMHardwareVersion(ctx, d, meta, environmentID, id); err != nil {
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("network_interface") {
 
-		if err = forceRunstate(ctx, meta, environmentID, id, skytap.VMRunstateStopped); err != nil {
+		if err = stopVM(ctx, d, meta, environmentID, id, schema.TimeoutUpdate); err != nil {
 			return diag.FromErr(err)
 		}
 
@@ -568,6 +768,18 @@ func resourceSkytapVMUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		remove := old.(*schema.Set).Difference(new.(*schema.Set))
 		add := new.(*schema.Set).Difference(old.(*schema.Set))
 
+		// the interfaces whose type alone changed are updated in place, keeping their MAC address
+		retyped := retypedNetworkInterfaces(remove, add)
+		vmNetworkInterfaces := make([]skytap.Interface, 0, len(retyped)+add.Len())
+		for interfaceID, nicType := range retyped {
+			log.Printf("[INFO] changing the type of network interface (%s) to %s", interfaceID, nicType)
+			vmInterface, err := meta.(*SkytapClient).vmManagementClient.UpdateInterfaceType(ctx, environmentID, id, interfaceID, skytap.NICType(nicType))
+			if err != nil {
+				return diag.Errorf("error changing the type of network interface (%s): %v", interfaceID, err)
+			}
+			vmNetworkInterfaces = append(vmNetworkInterfaces, *vmInterface)
+		}
+
 		for _, l := range remove.List() {
 			label := l.(map[string]interface{})
 			if err = interfacesClient.Delete(ctx, environmentID, id, label["id"].(string)); err != nil {
@@ -575,15 +787,14 @@ func resourceSkytapVMUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			}
 		}
 
-		vmNetworkInterfaces := make([]skytap.Interface, add.Len())
-		for idx, l := range add.List() {
+		for _, l := range add.List() {
 			vmInterface, err := addNetworkAdapter(ctx, meta, environmentID, id, l)
 			if err != nil {
 				return diag.FromErr(err)
 			}
 
 			if vmInterface != nil {
-				vmNetworkInterfaces[idx] = *vmInterface
+				vmNetworkInterfaces = append(vmNetworkInterfaces, *vmInterface)
 			}
 
 		}
@@ -599,72 +810,223 @@ func resourceSkytapVMUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.FromErr(err)
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    getVMPendingUpdateRunstates(false),
-		Target:     getVMTargetUpdateRunstates(false),
-		Refresh:    vmRunstateRefreshFunc(ctx, d, meta),
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
-		MinTimeout: minTimeout * time.Second,
-		Delay:      delay * time.Second,
+	if err = waitForVMUpdated(ctx, d, meta, schema.TimeoutUpdate); err != nil {
+		return diag.FromErr(err)
 	}
 
-	log.Printf("[INFO] Waiting for VM (%s) to complete", d.Id())
-	_, err = stateConf.WaitForStateContext(ctx)
-	if err != nil {
-		return diag.Errorf("error waiting for VM (%s) to complete: %s", d.Id(), err)
-	}
+	return readVMAfterUpdate(ctx, d, meta, powerCycle)
+}
 
-	return resourceSkytapVMRead(ctx, d, meta)
+// readVMAfterUpdate reads the updated VM, keeping the applied power cycle attributes of the plan until the next
+// refresh clears them
+func readVMAfterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, powerCycle []string) diag.Diagnostics {
+	if diags := resourceSkytapVMRead(ctx, d, meta); diags.HasError() || d.Id() == "" {
+		return diags
+	}
+	if err := d.Set("power_cycle_attributes", powerCycle); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
-func resourceSkytapVMDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+// beginVMUpdate waits for the VM to be mutable, checks it may be stopped for the power cycle changes and applies the
+// changes which do not need a power cycle: the name, user data and labels. It returns the VM before the update.
+func beginVMUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, environmentID string, id string,
+	timeout string, powerCycle []string) (*skytap.VM, error) {
 	client := meta.(*SkytapClient).vmsClient
 
-	environmentID := d.Get("environment_id").(string)
-	id := d.Id()
-
-	log.Printf("[INFO] destroying VM ID: %s", id)
-	err := client.Delete(ctx, environmentID, id)
+	vm, err := waitForVMMutable(ctx, d, meta, environmentID, id, timeout)
 	if err != nil {
-		if utils.ResponseErrorIsNotFound(err) {
-			log.Printf("[DEBUG] VM (%s) was not found - assuming removed", id)
-			return nil
-		}
+		return nil, err
+	}
+	// the VM may have been started since the plan
+	if err = checkVMStopAllowed(d.Get("allow_stop_for_update").(bool), vm, powerCycle); err != nil {
+		return nil, err
+	}
 
-		return diag.Errorf("error deleting VM (%s): %v", id, err)
+	// the name, user data and labels are updated while the VM is running
+	if err = renameVM(ctx, d, meta, environmentID, id); err != nil {
+		return nil, err
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"false"},
-		Target:     []string{"true"},
-		Refresh:    vmDeleteRefreshFunc(ctx, d, meta),
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		MinTimeout: minTimeout * time.Second,
-		Delay:      delay * time.Second,
+	if err = updateVMUserData(ctx, d, client, environmentID, id); err != nil {
+		return nil, err
 	}
 
-	log.Printf("[INFO] Waiting for VM (%s) to complete", d.Id())
-	_, err = stateConf.WaitForStateContext(ctx)
-	if err != nil {
-		return diag.Errorf("error waiting for VM (%s) to complete: %s", d.Id(), err)
+	if err = updateVMLabels(ctx, d, client, environmentID, id); err != nil {
+		return nil, err
 	}
-	if err = waitForEnvironmentReady(ctx, d, meta, environmentID, schema.TimeoutDelete); err != nil {
-		return diag.FromErr(err)
+	return vm, nil
+}
+
+// updateVMSizing stops the VM and applies the cpus and ram changes, keeping its disks
+func updateVMSizing(ctx context.Context, d *schema.ResourceData, meta interface{}, environmentID string, id string,
+	vm *skytap.VM, timeout string) error {
+	if !d.HasChanges("ram", "cpus") {
+		return nil
+	}
+
+	hardware := &skytap.UpdateHardware{
+		UpdateDisks: &skytap.UpdateDisks{
+			DiskIdentification: vmDiskIdentification(vm),
+		},
+	}
+	if err := updateHardwareSizing(d, hardware, vm); err != nil {
+		return err
+	}
+	opts := skytap.UpdateVMRequest{Hardware: hardware}
+
+	if err := stopVM(ctx, d, meta, environmentID, id, timeout); err != nil {
+		return err
 	}
-	log.Printf("[INFO] destroyed VM ID: %s", id)
 
+	log.Printf("[INFO] VM update: %s", id)
+	log.Printf("[TRACE] VM update options: %v", spew.Sdump(opts))
+	vmUpdated, err := meta.(*SkytapClient).vmsClient.Update(ctx, environmentID, id, &opts)
+	if err != nil {
+		return fmt.Errorf("error updating vm (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] updated VM: %s", id)
+	log.Printf("[TRACE] updated VM: %v", spew.Sdump(vmUpdated))
 	return nil
 }
 
-func addNetworkAdapters(ctx context.Context, d *schema.ResourceData, meta interface{}, vmID string) (interface{}, error) {
-	client := meta.(*SkytapClient).interfacesClient
-	environmentID := d.Get("environment_id").(string)
-	networkIfaceCount := d.Get("network_interface.#").(int)
+// updateVMOSDiskSize stops the VM and grows its OS disk to `os_disk_size`
+func updateVMOSDiskSize(ctx context.Context, d *schema.ResourceData, meta interface{}, environmentID string, id string,
+	vm *skytap.VM, timeout string) error {
+	if !d.HasChange("os_disk_size") {
+		return nil
+	}
 
-	// In case there is network interface defined
-	// we remove the default networks from the VM before create the network defined
-	if networkIfaceCount > 0 {
-		vmIfaces, err := client.List(ctx, environmentID, vmID)
+	disks := &VMDisksUpdate{Existing: make(map[string]skytap.ExistingDisk)}
+	if err := vmOSDiskResize(d, vm, disks.Existing); err != nil {
+		return err
+	}
+
+	if err := stopVM(ctx, d, meta, environmentID, id, timeout); err != nil {
+		return err
+	}
+	return applyVMDiskChanges(ctx, d, meta, environmentID, id, disks, nil, timeout)
+}
+
+// renameVM applies a change of name without stopping the VM
+func renameVM(ctx context.Context, d *schema.ResourceData, meta interface{}, environmentID string, id string) error {
+	if v, ok := d.GetOk("name"); ok && d.HasChange("name") {
+		log.Printf("[INFO] VM rename: %s", id)
+		vm, err := meta.(*SkytapClient).vmManagementClient.Rename(ctx, environmentID, id, v.(string))
+		if err != nil {
+			return fmt.Errorf("error renaming VM (%s): %v", id, err)
+		}
+		log.Printf("[INFO] renamed VM: %s", id)
+		log.Printf("[TRACE] renamed VM: %v", spew.Sdump(vm))
+	}
+	return nil
+}
+
+func updateVMUserData(ctx context.Context, d *schema.ResourceData, client skytap.VMsService, environmentID string, id string) error {
+	if d.HasChange("user_data") {
+		if userData, ok := d.GetOk("user_data"); ok {
+			if err := client.UpdateUserData(ctx, environmentID, id, utils.String(userData.(string))); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func updateVMLabels(ctx context.Context, d *schema.ResourceData, client skytap.VMsService, environmentID string, id string) error {
+	if d.HasChange("label") {
+		old, new := d.GetChange("label")
+		remove := old.(*schema.Set).Difference(new.(*schema.Set))
+		add := new.(*schema.Set).Difference(old.(*schema.Set))
+
+		for _, l := range remove.List() {
+			label := l.(map[string]interface{})
+			if err := client.DeleteLabel(ctx, environmentID, id, label["id"].(string)); err != nil {
+				return err
+			}
+		}
+		labelsToAdd := vmCreateLabels(add)
+
+		for _, l := range labelsToAdd {
+			if err := client.CreateLabel(ctx, environmentID, id, l); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func waitForVMUpdated(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    getVMPendingUpdateRunstates(false),
+		Target:     getVMTargetUpdateRunstates(false),
+		Refresh:    vmRunstateRefreshFunc(ctx, d, meta),
+		Timeout:    d.Timeout(timeout),
+		MinTimeout: minTimeout * time.Second,
+		Delay:      delay * time.Second,
+	}
+
+	log.Printf("[INFO] Waiting for VM (%s) to complete", d.Id())
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for VM (%s) to complete: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceSkytapVMDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).vmsClient
+
+	environmentID := d.Get("environment_id").(string)
+	id := d.Id()
+
+	_, err := waitForVMMutable(ctx, d, meta, environmentID, id, schema.TimeoutDelete)
+	if err == nil {
+		log.Printf("[INFO] destroying VM ID: %s", id)
+		err = client.Delete(ctx, environmentID, id)
+	}
+	if err != nil {
+		if utils.ResponseErrorIsNotFound(err) {
+			log.Printf("[DEBUG] VM (%s) was not found - assuming removed", id)
+			return nil
+		}
+
+		return diag.Errorf("error deleting VM (%s): %v", id, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"false"},
+		Target:     []string{"true"},
+		Refresh:    vmDeleteRefreshFunc(ctx, d, meta),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: minTimeout * time.Second,
+		Delay:      delay * time.Second,
+	}
+
+	log.Printf("[INFO] Waiting for VM (%s) to complete", d.Id())
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for VM (%s) to complete: %s", d.Id(), err)
+	}
+	if err = waitForEnvironmentReady(ctx, d, meta, environmentID, schema.TimeoutDelete); err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] destroyed VM ID: %s", id)
+
+	return nil
+}
+
+func addNetworkAdapters(ctx context.Context, d *schema.ResourceData, meta interface{}, vmID string) (interface{}, error) {
+	client := meta.(*SkytapClient).interfacesClient
+	environmentID := d.Get("environment_id").(string)
+	networkIfaceCount := d.Get("network_interface.#").(int)
+
+	// In case there is network interface defined
+	// we remove the default networks from the VM before create the network defined
+	if networkIfaceCount > 0 {
+		vmIfaces, err := client.List(ctx, environmentID, vmID)
 		if err != nil {
 			return nil, fmt.Errorf("error resolving VM network interfaces: %v", err)
 		}
@@ -794,17 +1156,20 @@ func addPublishedServices(ctx context.Context, meta interface{}, environmentID s
 	return nil
 }
 
-func addVMHardware(ctx context.Context, d *schema.ResourceData, meta interface{}, environmentID string, vmID string) (interface{}, error) {
+// addVMHardware applies the name, cpus and ram of a new VM, keeping its disks
+func addVMHardware(ctx context.Context, d *schema.ResourceData, meta interface{}, environmentID string, vmID string) error {
 	client := meta.(*SkytapClient).vmsClient
 
 	vm, err := client.Get(ctx, environmentID, vmID)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	opts := skytap.UpdateVMRequest{
 		Hardware: &skytap.UpdateHardware{
-			UpdateDisks: &skytap.UpdateDisks{},
+			UpdateDisks: &skytap.UpdateDisks{
+				DiskIdentification: vmDiskIdentification(vm),
+			},
 		},
 	}
 
@@ -814,17 +1179,17 @@ func addVMHardware(ctx context.Context, d *schema.ResourceData, meta interface{}
 	if v, ok := d.GetOk("cpus"); ok {
 		opts.Hardware.CPUs = utils.Int(v.(int))
 		if *opts.Hardware.CPUs > *vm.Hardware.MaxCPUs {
-			return nil, outOfRangeError("cpus", *opts.Hardware.CPUs, *vm.Hardware.MaxCPUs)
+			return outOfRangeError("cpus", *opts.Hardware.CPUs, *vm.Hardware.MaxCPUs)
 		}
 	}
 	if v, ok := d.GetOk("ram"); ok {
 		opts.Hardware.RAM = utils.Int(v.(int))
 		if *opts.Hardware.RAM > *vm.Hardware.MaxRAM {
-			return nil, outOfRangeError("ram", *opts.Hardware.RAM, *vm.Hardware.MaxRAM)
+			return outOfRangeError("ram", *opts.Hardware.RAM, *vm.Hardware.MaxRAM)
 		}
 	}
 
-	// Check vCPUs does not exceed RAM
+	// Check vCPUs does not exceed RAM, a rule of x86 VMs
 	var ramGBs = mbToGb(*vm.Hardware.RAM)
 	if opts.Hardware.RAM != nil {
 		ramGBs = mbToGb(*opts.Hardware.RAM)
@@ -833,128 +1198,585 @@ func addVMHardware(ctx context.Context, d *schema.ResourceData, meta interface{}
 	if opts.Hardware.CPUs != nil {
 		vCPUs = *opts.Hardware.CPUs
 	}
-	if vCPUs > ramGBs {
-		return nil, cpusExceedsRamError(vCPUs, ramGBs)
+	if !isPowerArchitecture(vm.Hardware) && vCPUs > ramGBs {
+		return cpusExceedsRamError(vCPUs, ramGBs)
 	}
 
-	if v, ok := d.GetOk("os_disk_size"); ok {
-		sizeNew := v.(int)
-		opts.Hardware.UpdateDisks.OSSize = utils.Int(sizeNew)
-		err := checkDiskNotShrunk(*vm.Hardware.Disks[0].Size, sizeNew, "OS")
-		if err != nil {
-			return nil, err
+	log.Printf("[INFO] VM create update: %s", *vm.ID)
+	log.Printf("[TRACE] VM create update options: %v", spew.Sdump(opts))
+	vmUpdated, err := client.Update(ctx, environmentID, *vm.ID, &opts)
+	if err != nil {
+		return fmt.Errorf("error updating vm (%s): %v", *vm.ID, err)
+	}
+	log.Printf("[INFO] updated VM after create: %s", *vm.ID)
+	log.Printf("[TRACE] updated VM after create: %v", spew.Sdump(vmUpdated))
+
+	return nil
+}
+
+// updateVMDisks adds the new disks of the `disk` set with their type, grows the resized disks and the OS disk, and
+// removes the data disks which are not in the set. The VM must be stopped. The disks of an IBM Power VM are left
+// unchanged.
+func updateVMDisks(ctx context.Context, d *schema.ResourceData, meta interface{}, environmentID string, id string, timeout string) error {
+	vm, err := meta.(*SkytapClient).vmsClient.Get(ctx, environmentID, id)
+	if err != nil {
+		return fmt.Errorf("error retrieving VM (%s): %v", id, err)
+	}
+	if isPowerArchitecture(vm.Hardware) {
+		return nil
+	}
+
+	changes := &VMDisksUpdate{Existing: make(map[string]skytap.ExistingDisk)}
+	if d.IsNewResource() || d.HasChange("os_disk_size") {
+		if err = vmOSDiskResize(d, vm, changes.Existing); err != nil {
+			return err
 		}
 	}
 
-	if v, ok := d.GetOk("disk"); ok {
-		diskSet := v.(*schema.Set)
-		log.Printf("[INFO] creating %d disks", diskSet.Len())
-		opts.Hardware.UpdateDisks.NewDisks = make([]int, d.Get("disk.#").(int))
-		opts.Hardware.UpdateDisks.DiskIdentification = make([]skytap.DiskIdentification, d.Get("disk.#").(int))
-		for idx, disk := range diskSet.List() {
-			diskMap := disk.(map[string]interface{})
-			opts.Hardware.UpdateDisks.NewDisks[idx] = diskMap["size"].(int)
-			opts.Hardware.UpdateDisks.DiskIdentification[idx] = skytap.DiskIdentification{
-				ID: nil, Name: utils.String(diskMap["name"].(string)), Size: utils.Int(diskMap["size"].(int)),
+	if !d.IsNewResource() && !d.HasChange("disk") {
+		return applyVMDiskChanges(ctx, d, meta, environmentID, id, changes, nil, timeout)
+	}
+
+	// the disks are matched to the previous state by name
+	names := make(map[string]string)
+	added := make([]interface{}, 0)
+	oldDisks, newDisks := d.GetChange("disk")
+	for _, disk := range newDisks.(*schema.Set).List() {
+		diskMap := disk.(map[string]interface{})
+		name := diskMap["name"].(string)
+		size := diskMap["size"].(int)
+		diskID, sizeOld := retrieveIDsFromOldState(oldDisks.(*schema.Set), name)
+		if diskID == "" {
+			newDisk := NewVMDisk{Size: utils.Int(size)}
+			if diskType, ok := diskMap["type"].(string); ok && diskType != "" {
+				newDisk.Type = utils.String(diskType)
 			}
+			changes.New = append(changes.New, newDisk)
+			added = append(added, disk)
+			continue
+		}
+
+		if err = checkDiskNotShrunk(sizeOld, size, name); err != nil {
+			return err
+		}
+		if err = checkDiskTypeUnchanged(vm.Hardware.Disks, diskID, diskMap["type"].(string), name); err != nil {
+			return err
+		}
+		names[diskID] = name
+		if size > sizeOld {
+			changes.Existing[diskID] = skytap.ExistingDisk{ID: utils.String(diskID), Size: utils.Int(size)}
 		}
-	} else {
-		opts.Hardware.UpdateDisks.DiskIdentification = make([]skytap.DiskIdentification, 0)
 	}
 
-	log.Printf("[INFO] VM create update: %s", *vm.ID)
-	log.Printf("[TRACE] VM create update options: %v", spew.Sdump(opts))
-	vmUpdated, err := client.Update(ctx, environmentID, *vm.ID, &opts)
+	removes := make(map[string]skytap.ExistingDisk)
+	osDisk := osDiskIndex(vm.Hardware.Disks)
+	for idx, disk := range vm.Hardware.Disks {
+		// ignore os disk
+		if _, ok := names[*disk.ID]; idx != osDisk && !ok {
+			removes[*disk.ID] = skytap.ExistingDisk{ID: disk.ID}
+		}
+	}
+	if len(changes.New) > 0 {
+		log.Printf("[INFO] creating %d disk(s)", len(changes.New))
+	}
+	if len(removes) > 0 {
+		log.Printf("[INFO] removing %d disk(s)", len(removes))
+	}
+
+	if err = applyVMDiskChanges(ctx, d, meta, environmentID, id, changes, removes, timeout); err != nil {
+		return err
+	}
+
+	vm, err = meta.(*SkytapClient).vmsClient.Get(ctx, environmentID, id)
 	if err != nil {
-		return nil, fmt.Errorf("error updating vm (%s): %v", *vm.ID, err)
+		return fmt.Errorf("error retrieving VM (%s): %v", id, err)
 	}
-	log.Printf("[INFO] updated VM after create: %s", *vm.ID)
-	log.Printf("[TRACE] updated VM after create: %v", spew.Sdump(vmUpdated))
+	nameVMDisks(vm.Hardware.Disks, names, added)
 
-	// Have to do this in order to capture `name`
-	return flattenDisks(vmUpdated.Hardware.Disks), nil
+	disks := clearDiskTypes(flattenDisks(vm.Hardware.Disks), configuredDiskTypes(newDisks.(*schema.Set)))
+	if err = d.Set("disk", disks); err != nil {
+		log.Printf("[ERROR] error flattening disks: %v", err)
+		return err
+	}
+	return nil
 }
 
-func outOfRangeError(field string, value int, max int) error {
-	return fmt.Errorf("the '%s' argument has been assigned (%d) which is more "+
-		"than the maximum allowed (%d) as defined by this VM",
-		field, value, max)
+// vmOSDiskResize adds the growth of the OS disk, the disk of the VM at LUN 0, to the existing disk changes
+func vmOSDiskResize(d *schema.ResourceData, vm *skytap.VM, existing map[string]skytap.ExistingDisk) error {
+	size, ok := d.GetOk("os_disk_size")
+	if ok && isPowerArchitecture(vm.Hardware) {
+		return fmt.Errorf("the 'os_disk_size' argument is not supported by IBM Power VMs")
+	}
+	idx := osDiskIndex(vm.Hardware.Disks)
+	if !ok || idx < 0 {
+		return nil
+	}
+
+	osDisk := vm.Hardware.Disks[idx]
+	if err := checkDiskNotShrunk(*osDisk.Size, size.(int), "OS"); err != nil {
+		return err
+	}
+	if size.(int) > *osDisk.Size {
+		existing[*osDisk.ID] = skytap.ExistingDisk{ID: osDisk.ID, Size: utils.Int(size.(int))}
+	}
+	return nil
 }
 
-func updateHardware(d *schema.ResourceData) (*skytap.UpdateHardware, error) {
-	var hardware = &skytap.UpdateHardware{
-		UpdateDisks: &skytap.UpdateDisks{},
+// applyVMDiskChanges requests the new and resized disks, then the removal of disks, waiting for the VM after each
+// request
+func applyVMDiskChanges(ctx context.Context, d *schema.ResourceData, meta interface{}, environmentID string, id string,
+	changes *VMDisksUpdate, removes map[string]skytap.ExistingDisk, timeout string) error {
+	client := meta.(*SkytapClient).vmManagementClient
+
+	if len(changes.New) > 0 || len(changes.Existing) > 0 {
+		opts := UpdateVMDisksRequest{Hardware: &VMDisksHardware{Disks: changes}}
+
+		log.Printf("[INFO] VM disks update: %s", id)
+		log.Printf("[TRACE] VM disks update options: %v", spew.Sdump(opts))
+		vm, err := client.UpdateDisks(ctx, environmentID, id, &opts)
+		if err != nil {
+			return fmt.Errorf("error updating disks of VM (%s): %v", id, err)
+		}
+		log.Printf("[INFO] updated VM disks: %s", id)
+		log.Printf("[TRACE] updated VM disks: %v", spew.Sdump(vm))
+
+		if err = waitForVMUpdated(ctx, d, meta, timeout); err != nil {
+			return err
+		}
 	}
-	if _, ok := d.GetOk("disk"); ok {
-		oldDisks, newDisks := d.GetChange("disk")
-		diskSet := newDisks.(*schema.Set)
-		diskIDs := make([]skytap.DiskIdentification, 0)
-		disksNew := make([]int, 0)
-		// adds and initialises disk identification struct
-		for _, disk := range diskSet.List() {
-			diskMap := disk.(map[string]interface{})
-			name := diskMap["name"].(string)
-			sizeNew := diskMap["size"].(int)
-			id, sizeOld := retrieveIDsFromOldState(oldDisks.(*schema.Set), name)
-			if id == "" { // new
-				disksNew = append(disksNew, sizeNew)
-			} else {
-				err := checkDiskNotShrunk(sizeOld, sizeNew, name)
-				if err != nil {
-					return nil, err
-				}
-			}
-			diskID := skytap.DiskIdentification{
-				ID: utils.String(id), Name: utils.String(name), Size: utils.Int(sizeNew),
-			}
-			diskIDs = append(diskIDs, diskID)
+
+	if len(removes) > 0 {
+		opts := UpdateVMDisksRequest{Hardware: &VMDisksHardware{Disks: &VMDisksUpdate{Existing: removes}}}
+
+		log.Printf("[INFO] VM disks removal: %s", id)
+		log.Printf("[TRACE] VM disks removal options: %v", spew.Sdump(opts))
+		vm, err := client.UpdateDisks(ctx, environmentID, id, &opts)
+		if err != nil {
+			return fmt.Errorf("error removing disks of VM (%s): %v", id, err)
 		}
-		hardware.UpdateDisks.DiskIdentification = diskIDs
-		if len(disksNew) > 0 {
-			log.Printf("[INFO] creating %d disk(s)", len(disksNew))
-			hardware.UpdateDisks.NewDisks = disksNew
+		log.Printf("[INFO] removed VM disks: %s", id)
+		log.Printf("[TRACE] removed VM disks: %v", spew.Sdump(vm))
+
+		if err = waitForVMUpdated(ctx, d, meta, timeout); err != nil {
+			return err
 		}
-	} else {
-		hardware.UpdateDisks.DiskIdentification = make([]skytap.DiskIdentification, 0)
 	}
+	return nil
+}
+
+func outOfRangeError(field string, value int, max int) error {
+	return fmt.Errorf("the '%s' argument has been assigned (%d) which is more "+
+		"than the maximum allowed (%d) as defined by this VM",
+		field, value, max)
+}
 
+// updateHardwareSizing adds the ram and cpus changes to the hardware update, checking the VM limits
+func updateHardwareSizing(d *schema.ResourceData, hardware *skytap.UpdateHardware, vm *skytap.VM) error {
 	if ram, ok := d.GetOk("ram"); ok && d.HasChange("ram") {
 		hardware.RAM = utils.Int(ram.(int))
 		if maxRAM, maxOK := d.GetOk("max_ram"); maxOK {
 			if *hardware.RAM > maxRAM.(int) {
-				return nil, outOfRangeError("ram", *hardware.RAM, maxRAM.(int))
+				return outOfRangeError("ram", *hardware.RAM, maxRAM.(int))
 			}
 		} else {
-			return nil, fmt.Errorf("unable to read the 'max_ram' element")
+			return fmt.Errorf("unable to read the 'max_ram' element")
 		}
 	}
 	if cpus, ok := d.GetOk("cpus"); ok && d.HasChange("cpus") {
 		hardware.CPUs = utils.Int(cpus.(int))
 		if maxCPUs, maxOK := d.GetOk("max_cpus"); maxOK {
 			if *hardware.CPUs > maxCPUs.(int) {
-				return nil, outOfRangeError("cpus", *hardware.CPUs, maxCPUs.(int))
+				return outOfRangeError("cpus", *hardware.CPUs, maxCPUs.(int))
 			}
 		} else {
-			return nil, fmt.Errorf("unable to read the 'max_cpus' element")
+			return fmt.Errorf("unable to read the 'max_cpus' element")
+		}
+
+		if ram, ok := d.GetOk("ram"); ok && !isPowerArchitecture(vm.Hardware) && cpus.(int) > mbToGb(ram.(int)) {
+			return cpusExceedsRamError(cpus.(int), mbToGb(ram.(int)))
+		}
+	}
+
+	return nil
+}
+
+// vmHardwareSettingsKeys are the hardware arguments updated through the VMManagementService
+var vmHardwareSettingsKeys = append([]string{
+	"copy_paste_enabled", "vnc_keymap",
+}, vmStoppedHardwareSettingsKeys...)
+
+// vmStoppedHardwareSettingsKeys are the hardware settings Skytap only changes while the VM is powered off
+var vmStoppedHardwareSettingsKeys = []string{
+	"cpus_per_socket", "nested_virtualization", "time_sync_enabled", "rtc_start_time", "guest_os",
+}
+
+// vmHardwareSettings builds the hardware settings configured for a new VM or changed since the last apply
+func vmHardwareSettings(d *schema.ResourceData) *VMHardwareSettings {
+	settings := VMHardwareSettings{}
+	updated := false
+	setting := func(key string) (interface{}, bool) {
+		v, ok := d.GetOkExists(key)
+		ok = ok && (d.IsNewResource() || d.HasChange(key))
+		updated = updated || ok
+		return v, ok
+	}
+
+	if v, ok := setting("cpus_per_socket"); ok {
+		settings.CpusPerSocket = utils.Int(v.(int))
+	}
+	if v, ok := setting("nested_virtualization"); ok {
+		settings.NestedVirtualization = utils.Bool(v.(bool))
+	}
+	if v, ok := setting("time_sync_enabled"); ok {
+		settings.TimeSyncEnabled = utils.Bool(v.(bool))
+	}
+	if v, ok := setting("copy_paste_enabled"); ok {
+		settings.CopyPasteEnabled = utils.Bool(v.(bool))
+	}
+	if v, ok := setting("vnc_keymap"); ok {
+		settings.VncKeymap = utils.String(v.(string))
+	}
+	if v, ok := setting("rtc_start_time"); ok {
+		settings.RTCStartTime = utils.String(v.(string))
+	}
+	if v, ok := setting("guest_os"); ok {
+		settings.GuestOS = utils.String(v.(string))
+	}
+
+	if !updated {
+		return nil
+	}
+	return &settings
+}
+
+// updateVMHardwareSettings applies the hardware settings not supported by the SDK. The VM must be stopped.
+func updateVMHardwareSettings(ctx context.Context, d *schema.ResourceData, meta interface{}, environmentID string, id string) error {
+	settings := vmHardwareSettings(d)
+	if settings == nil {
+		return nil
+	}
+
+	if settings.CpusPerSocket != nil {
+		if cpus := d.Get("cpus").(int); cpus > 0 && cpus%*settings.CpusPerSocket != 0 {
+			return fmt.Errorf("the 'cpus' argument (%d) must be a multiple of the 'cpus_per_socket' argument (%d)", cpus, *settings.CpusPerSocket)
+		}
+	}
+
+	opts := UpdateVMHardwareRequest{Hardware: settings}
+
+	log.Printf("[INFO] VM hardware settings update: %s", id)
+	log.Printf("[TRACE] VM hardware settings update options: %v", spew.Sdump(opts))
+	vm, err := meta.(*SkytapClient).vmManagementClient.UpdateHardware(ctx, environmentID, id, &opts)
+	if err != nil {
+		return fmt.Errorf("error updating hardware settings of VM (%s): %v", id, err)
+	}
+	log.Printf("[INFO] updated VM hardware settings: %s", id)
+	log.Printf("[TRACE] updated VM hardware settings: %v", spew.Sdump(vm))
+
+	return nil
+}
+
+// vmPowerCycleKeys are the arguments whose update stops the VM
+var vmPowerCycleKeys = append([]string{
+	"cpus", "ram", "os_disk_size", "disk", "network_interface", "hardware_version",
+}, vmStoppedHardwareSettingsKeys...)
+
+// resourceSkytapVMCustomizeDiff rejects invalid hardware at plan time, using the limits of the source VM for a new
+// VM and the limits held in the state otherwise, and lists the changes which need a power cycle
+func resourceSkytapVMCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		if err := d.SetNew("power_cycle_attributes", []string{}); err != nil {
+			return err
 		}
 
-		if ram, ok := d.GetOk("ram"); ok && cpus.(int) > mbToGb(ram.(int)) {
-			return nil, cpusExceedsRamError(cpus.(int), mbToGb(ram.(int)))
+		source, err := vmCustomizeDiffSource(ctx, d, meta)
+		if err != nil || source == nil || source.Hardware == nil {
+			return err
 		}
+		if err = checkVMArchitectureDiff(d, source.Hardware); err != nil {
+			return err
+		}
+		return checkVMHardwareDiff(d, source.Hardware)
 	}
 
-	if _, ok := d.GetOk("os_disk_size"); ok && d.HasChange("os_disk_size") {
+	if len(d.GetChangedKeysPrefix("")) > 0 {
+		powerCycle := vmPowerCycleChanges(d, vmPowerCycleKeys)
+		if err := d.SetNew("power_cycle_attributes", powerCycle); err != nil {
+			return err
+		}
+		if err := checkVMStopAllowedDiff(ctx, d, meta, powerCycle); err != nil {
+			return err
+		}
+	}
+
+	hardware := &skytap.Hardware{
+		CPUs:         utils.Int(d.Get("cpus").(int)),
+		RAM:          utils.Int(d.Get("ram").(int)),
+		MaxCPUs:      utils.Int(d.Get("max_cpus").(int)),
+		MaxRAM:       utils.Int(d.Get("max_ram").(int)),
+		Architecture: utils.String(d.Get("architecture").(string)),
+	}
+	if err := checkVMArchitectureDiff(d, hardware); err != nil {
+		return err
+	}
+	if err := checkVMHardwareDiff(d, hardware); err != nil {
+		return err
+	}
+
+	if d.HasChange("os_disk_size") && d.NewValueKnown("os_disk_size") {
 		sizeOld, sizeNew := d.GetChange("os_disk_size")
-		sizeOldInt := sizeOld.(int)
-		sizeNewInt := sizeNew.(int)
-		hardware.UpdateDisks.OSSize = utils.Int(sizeNewInt)
-		err := checkDiskNotShrunk(sizeOldInt, sizeNewInt, "OS")
+		if err := checkDiskNotShrunk(sizeOld.(int), sizeNew.(int), "OS"); err != nil {
+			return err
+		}
+	}
+	if d.HasChange("disk") {
+		oldDisks, newDisks := d.GetChange("disk")
+		oldTypes := make(map[string]string)
+		for _, disk := range oldDisks.(*schema.Set).List() {
+			diskMap := disk.(map[string]interface{})
+			oldTypes[diskMap["name"].(string)] = diskMap["type"].(string)
+		}
+		for _, disk := range newDisks.(*schema.Set).List() {
+			diskMap := disk.(map[string]interface{})
+			name := diskMap["name"].(string)
+			if id, sizeOld := retrieveIDsFromOldState(oldDisks.(*schema.Set), name); id != "" {
+				if err := checkDiskNotShrunk(sizeOld, diskMap["size"].(int), name); err != nil {
+					return err
+				}
+				// the type is only known here when it was configured, an unconfigured type is checked on apply
+				if diskType, _ := diskMap["type"].(string); diskType != "" && oldTypes[name] != "" &&
+					!strings.EqualFold(diskType, oldTypes[name]) {
+					return fmt.Errorf("the type of disk (%s) cannot be changed from (%s) to (%s)", name, oldTypes[name], diskType)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// vmPowerCycleChanges returns the changed arguments among the keys whose update stops the VM
+func vmPowerCycleChanges(d interface{ HasChange(string) bool }, keys []string) []string {
+	powerCycle := make([]string, 0)
+	for _, key := range keys {
+		if d.HasChange(key) {
+			powerCycle = append(powerCycle, key)
+		}
+	}
+	return powerCycle
+}
+
+// checkVMStopAllowed rejects changes which would stop the running VM when `allow_stop_for_update` is false. It runs
+// at plan time, and again before the update as the VM may have been started since the plan.
+func checkVMStopAllowed(allowed bool, vm *skytap.VM, powerCycle []string) error {
+	if len(powerCycle) == 0 || allowed {
+		return nil
+	}
+	if vm.Runstate != nil && *vm.Runstate == skytap.VMRunstateStopped {
+		return nil
+	}
+	return fmt.Errorf("changing %s requires VM (%s) to be stopped, which is not allowed as 'allow_stop_for_update' is false",
+		strings.Join(powerCycle, ", "), *vm.ID)
+}
+
+// checkVMStopAllowedDiff rejects at plan time the power cycle changes which would stop the running VM when
+// `allow_stop_for_update` is false
+func checkVMStopAllowedDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}, powerCycle []string) error {
+	if len(powerCycle) == 0 || d.Get("allow_stop_for_update").(bool) {
+		return nil
+	}
+	vm, err := meta.(*SkytapClient).vmsClient.Get(ctx, d.Get("environment_id").(string), d.Id())
+	if err != nil {
+		return fmt.Errorf("error retrieving VM (%s): %v", d.Id(), err)
+	}
+	return checkVMStopAllowed(false, vm, powerCycle)
+}
+
+// vmCustomizeDiffSource retrieves the template or environment VM a new VM is created from, or nil if it is not known
+// at plan time
+func vmCustomizeDiffSource(ctx context.Context, d *schema.ResourceDiff, meta interface{}) (*skytap.VM, error) {
+	if !d.NewValueKnown("vm_id") || !d.NewValueKnown("template_id") || !d.NewValueKnown("source_environment_id") {
+		return nil, nil
+	}
+	vmID := d.Get("vm_id").(string)
+
+	if sourceEnvironmentID, ok := d.GetOk("source_environment_id"); ok {
+		vm, err := meta.(*SkytapClient).vmsClient.Get(ctx, sourceEnvironmentID.(string), vmID)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("error retrieving VM (%s) of source environment (%s): %v", vmID, sourceEnvironmentID, err)
+		}
+		return vm, nil
+	}
+
+	templateID := d.Get("template_id").(string)
+	template, err := meta.(*SkytapClient).templatesClient.Get(ctx, templateID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving template (%s): %v", templateID, err)
+	}
+	for idx := range template.VMs {
+		if template.VMs[idx].ID != nil && *template.VMs[idx].ID == vmID {
+			return &template.VMs[idx], nil
+		}
+	}
+	return nil, fmt.Errorf("VM (%s) not found in template (%s)", vmID, templateID)
+}
+
+const vmArchitecturePower = "power"
+
+// vmX86OnlyKeys are the arguments of the VMware hardware, which IBM Power VMs do not support
+var vmX86OnlyKeys = []string{
+	"cpus_per_socket", "nested_virtualization", "time_sync_enabled", "copy_paste_enabled", "vnc_keymap", "hardware_version",
+}
+
+// isPowerArchitecture reports whether the hardware is an IBM Power VM, which does not follow the x86 hardware rules
+func isPowerArchitecture(hardware *skytap.Hardware) bool {
+	return hardware != nil && hardware.Architecture != nil && *hardware.Architecture == vmArchitecturePower
+}
+
+// checkVMArchitectureDiff rejects the configuration an IBM Power VM does not support: the VMware hardware settings,
+// network interface types other than `default`, and the management of its disks
+func checkVMArchitectureDiff(d *schema.ResourceDiff, hardware *skytap.Hardware) error {
+	config := d.GetRawConfig()
+	if !isPowerArchitecture(hardware) || config.IsNull() {
+		return nil
+	}
+
+	for _, key := range vmX86OnlyKeys {
+		if !config.GetAttr(key).IsNull() {
+			return fmt.Errorf("the '%s' argument is not supported by IBM Power VMs", key)
 		}
 	}
 
-	return hardware, nil
+	if interfaces := config.GetAttr("network_interface"); interfaces.IsKnown() && !interfaces.IsNull() {
+		for it := interfaces.ElementIterator(); it.Next(); {
+			_, networkInterface := it.Element()
+			nicType := networkInterface.GetAttr("interface_type")
+			if nicType.IsKnown() && !nicType.IsNull() && nicType.AsString() != string(skytap.NICTypeDefault) {
+				return fmt.Errorf("the network interface type (%s) is not supported by IBM Power VMs, which only support (%s)",
+					nicType.AsString(), skytap.NICTypeDefault)
+			}
+		}
+	}
+
+	if !config.GetAttr("os_disk_size").IsNull() {
+		return fmt.Errorf("the 'os_disk_size' argument is not supported by IBM Power VMs")
+	}
+	if disks := config.GetAttr("disk"); !disks.IsNull() && (!disks.IsKnown() || disks.LengthInt() > 0) {
+		return fmt.Errorf("the 'disk' argument is not supported by IBM Power VMs, whose disks are not managed")
+	}
+	return nil
+}
+
+// checkVMHardwareDiff checks the configured cpus, ram and OS disk size against the limits of the hardware
+func checkVMHardwareDiff(d *schema.ResourceDiff, hardware *skytap.Hardware) error {
+	config := d.GetRawConfig()
+	if config.IsNull() {
+		return nil
+	}
+	// the values computed from another resource can only be checked during apply, so the checks which need them
+	// are skipped
+	known := func(key string) bool {
+		return config.GetAttr(key).IsKnown()
+	}
+	configured := func(key string) bool {
+		return known(key) && !config.GetAttr(key).IsNull() && d.HasChange(key)
+	}
+
+	cpus := 0
+	if hardware.CPUs != nil {
+		cpus = *hardware.CPUs
+	}
+	if configured("cpus") {
+		cpus = d.Get("cpus").(int)
+		if hardware.MaxCPUs != nil && *hardware.MaxCPUs > 0 && cpus > *hardware.MaxCPUs {
+			return outOfRangeError("cpus", cpus, *hardware.MaxCPUs)
+		}
+	}
+	ram := 0
+	if hardware.RAM != nil {
+		ram = *hardware.RAM
+	}
+	if configured("ram") {
+		ram = d.Get("ram").(int)
+		if hardware.MaxRAM != nil && *hardware.MaxRAM > 0 && ram > *hardware.MaxRAM {
+			return outOfRangeError("ram", ram, *hardware.MaxRAM)
+		}
+	}
+	if known("cpus") && known("ram") && (configured("cpus") || configured("ram")) &&
+		!isPowerArchitecture(hardware) && ram > 0 && cpus > mbToGb(ram) {
+		return cpusExceedsRamError(cpus, mbToGb(ram))
+	}
+
+	if osDisk := osDiskIndex(hardware.Disks); d.Id() == "" && configured("os_disk_size") && osDisk >= 0 &&
+		hardware.Disks[osDisk].Size != nil {
+		if err := checkDiskNotShrunk(*hardware.Disks[osDisk].Size, d.Get("os_disk_size").(int), "OS"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// upgradeVMHardwareVersion stops the VM and upgrades it to the configured hardware version
+func upgradeVMHardwareVersion(ctx context.Context, d *schema.ResourceData, meta interface{}, environmentID string, id string) error {
+	v, ok := d.GetOk("hardware_version")
+	if !ok || !(d.IsNewResource() || d.HasChange("hardware_version")) {
+		return nil
+	}
+	version := v.(int)
+
+	vm, err := meta.(*SkytapClient).vmsClient.Get(ctx, environmentID, id)
+	if err != nil {
+		return fmt.Errorf("error retrieving VM (%s): %v", id, err)
+	}
+	if vm.HardwareVersion != nil {
+		if version == *vm.HardwareVersion {
+			return nil
+		}
+		if version < *vm.HardwareVersion {
+			return fmt.Errorf("cannot downgrade the hardware version of VM (%s) from (%d) to (%d)", id, *vm.HardwareVersion, version)
+		}
+	}
+	if vm.MaxHardwareVersion != nil && version > *vm.MaxHardwareVersion {
+		return outOfRangeError("hardware_version", version, *vm.MaxHardwareVersion)
+	}
+
+	if err = stopVM(ctx, d, meta, environmentID, id, schema.TimeoutUpdate); err != nil {
+		return err
+	}
+
+	opts := UpdateVMHardwareRequest{HardwareVersion: utils.Int(version)}
+
+	log.Printf("[INFO] VM hardware version upgrade: %s", id)
+	log.Printf("[TRACE] VM hardware version upgrade options: %v", spew.Sdump(opts))
+	vm, err = meta.(*SkytapClient).vmManagementClient.UpdateHardware(ctx, environmentID, id, &opts)
+	if err != nil {
+		return fmt.Errorf("error upgrading hardware version of VM (%s): %v", id, err)
+	}
+	log.Printf("[INFO] upgraded VM hardware version: %s", id)
+	log.Printf("[TRACE] upgraded VM hardware version: %v", spew.Sdump(vm))
+
+	return nil
+}
+
+func setVMHardwareSettings(d *schema.ResourceData, hardware *skytap.Hardware) error {
+	if err := d.Set("cpus_per_socket", hardware.CpusPerSocket); err != nil {
+		return err
+	}
+	if err := d.Set("nested_virtualization", hardware.NestedVirtualization); err != nil {
+		return err
+	}
+	if err := d.Set("time_sync_enabled", hardware.TimeSyncEnabled); err != nil {
+		return err
+	}
+	if err := d.Set("copy_paste_enabled", hardware.CopyPasteEnabled); err != nil {
+		return err
+	}
+	if err := d.Set("vnc_keymap", hardware.VncKeymap); err != nil {
+		return err
+	}
+	if err := d.Set("rtc_start_time", hardware.RTCStartTime); err != nil {
+		return err
+	}
+	return d.Set("guest_os", hardware.GuestOS)
 }
 
 // Confirm size not shrunk
@@ -973,6 +1795,31 @@ func cpusExceedsRamError(cpus, ram int) error {
 	return fmt.Errorf("the 'cpus' argument has been assigned (%d) which is more than the maximum allowed (%d), the number of GB of RAM", cpus, ram)
 }
 
+// configuredDiskTypes returns the names of the disk blocks whose type is set
+func configuredDiskTypes(disks *schema.Set) map[string]bool {
+	configured := make(map[string]bool)
+	for _, disk := range disks.List() {
+		diskMap := disk.(map[string]interface{})
+		if diskType, _ := diskMap["type"].(string); diskType != "" {
+			configured[diskMap["name"].(string)] = true
+		}
+	}
+	return configured
+}
+
+// checkDiskTypeUnchanged checks the configured type of an existing disk matches the type of the disk
+func checkDiskTypeUnchanged(disks []skytap.Disk, id string, diskType string, name string) error {
+	if diskType == "" {
+		return nil
+	}
+	for _, disk := range disks {
+		if *disk.ID == id && disk.Type != nil && !strings.EqualFold(*disk.Type, diskType) {
+			return fmt.Errorf("the type of disk (%s) cannot be changed from (%s) to (%s)", name, *disk.Type, diskType)
+		}
+	}
+	return nil
+}
+
 func retrieveIDsFromOldState(d *schema.Set, name string) (string, int) {
 	for _, disk := range d.List() {
 		diskMap := disk.(map[string]interface{})
@@ -1043,10 +1890,67 @@ func vmRunstateRefreshFunc(
 
 		log.Printf("[DEBUG] VM status (%s): %s", id, *vm.Runstate)
 
+		if vm.MaintenanceLockEngaged != nil && *vm.MaintenanceLockEngaged {
+			return nil, "", vmMaintenanceLockError(id)
+		}
+
 		return vm, string(*vm.Runstate), nil
 	}
 }
 
+const (
+	vmStateReady       = "ready"
+	vmStateRateLimited = "rate_limited"
+)
+
+// waitForVMMutable retrieves the VM before it is changed, waiting while it is busy or rate limited. It fails fast when
+// the VM is locked for maintenance or its state cannot be changed by the user.
+func waitForVMMutable(ctx context.Context, d *schema.ResourceData, meta interface{}, environmentID string, id string, timeout string) (*skytap.VM, error) {
+	client := meta.(*SkytapClient).vmsClient
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{string(skytap.VMRunstateBusy), vmStateRateLimited},
+		Target:  []string{vmStateReady},
+		Refresh: func() (interface{}, string, error) {
+			log.Printf("[DEBUG] retrieving VM: %s", id)
+			vm, err := client.Get(ctx, environmentID, id)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if vm.MaintenanceLockEngaged != nil && *vm.MaintenanceLockEngaged {
+				return nil, "", vmMaintenanceLockError(id)
+			}
+			if vm.RateLimited != nil && *vm.RateLimited {
+				log.Printf("[INFO] VM (%s) is rate limited by Skytap, waiting before changing it", id)
+				return vm, vmStateRateLimited, nil
+			}
+			if vm.Runstate != nil && *vm.Runstate == skytap.VMRunstateBusy {
+				log.Printf("[INFO] VM (%s) is busy, waiting before changing it", id)
+				return vm, string(skytap.VMRunstateBusy), nil
+			}
+			if vm.CanChangeObjectState != nil && !*vm.CanChangeObjectState {
+				return nil, "", fmt.Errorf("the state of VM (%s) cannot be changed by this user: "+
+					"check the user's role in the environment and the project", id)
+			}
+			return vm, vmStateReady, nil
+		},
+		Timeout:    d.Timeout(timeout),
+		MinTimeout: minTimeout * time.Second,
+	}
+
+	vm, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return vm.(*skytap.VM), nil
+}
+
+func vmMaintenanceLockError(id string) error {
+	return fmt.Errorf("VM (%s) is locked for maintenance by Skytap and cannot be changed until the maintenance "+
+		"completes: retry the apply later", id)
+}
+
 func waitForVMStopped(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:    vmPendingCreateRunstates,
@@ -1096,6 +2000,15 @@ func vmCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, env
 	templateID := d.Get("template_id").(string)
 	templateVMID := d.Get("vm_id").(string)
 
+	// a VM is created by merging it into the environment, and the merges are told apart by what they add
+	locks := meta.(*SkytapClient).environmentLocks
+	locks.Lock(environmentID)
+	defer locks.Unlock(environmentID)
+
+	if sourceEnvironmentID, ok := d.GetOk("source_environment_id"); ok {
+		return vmCopy(ctx, d, meta, environmentID, sourceEnvironmentID.(string), templateVMID)
+	}
+
 	// create the VM
 	createOpts := skytap.CreateVMRequest{
 		TemplateID: templateID,
@@ -1114,6 +2027,100 @@ func vmCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, env
 	return *vm.ID, nil
 }
 
+// vmCopy copies the VM of the source environment by merging it into the environment
+func vmCopy(ctx context.Context, d *schema.ResourceData, meta interface{}, environmentID string, sourceEnvironmentID string, sourceVMID string) (string, error) {
+	client := meta.(*SkytapClient).environmentsClient
+
+	sourceVM, err := meta.(*SkytapClient).vmsClient.Get(ctx, sourceEnvironmentID, sourceVMID)
+	if err != nil {
+		return "", fmt.Errorf("error retrieving VM (%s) of source environment (%s): %v", sourceVMID, sourceEnvironmentID, err)
+	}
+
+	// the merge response does not identify the copy, so it is the VM missing before the merge named as the source VM
+	environment, err := client.Get(ctx, environmentID)
+	if err != nil {
+		return "", fmt.Errorf("error retrieving environment (%s): %v", environmentID, err)
+	}
+	vmIDsBefore, _ := environmentResourceIDs(environment)
+
+	opts := MergeEnvironmentRequest{
+		EnvironmentID: utils.String(sourceEnvironmentID),
+		VMIDs:         []string{sourceVMID},
+	}
+
+	log.Printf("[INFO] VM copy")
+	log.Printf("[TRACE] VM copy options: %v", spew.Sdump(opts))
+	environment, err = meta.(*SkytapClient).environmentManagementClient.Merge(ctx, environmentID, &opts)
+	if err != nil {
+		return "", fmt.Errorf("error copying VM: %v with source environment ID: %s and VM ID: %s", err, sourceEnvironmentID, sourceVMID)
+	}
+
+	copied := mergedVMIDs(vmIDsBefore, environment.VMs, []skytap.VM{*sourceVM})
+	if len(copied) == 0 {
+		// the copy may not keep the name of the source VM, which is only unambiguous when it is the single VM added
+		vmIDsAfter, _ := environmentResourceIDs(environment)
+		copied = addedIDs(vmIDsBefore, vmIDsAfter)
+	}
+	if len(copied) != 1 {
+		return "", fmt.Errorf("unable to identify the VM copied from source environment (%s): found %d candidates", sourceEnvironmentID, len(copied))
+	}
+	log.Printf("[INFO] copied VM: %s", copied[0])
+
+	return copied[0], nil
+}
+
+const (
+	vmStopMethodShutdown = "shutdown"
+	vmStopMethodHalted   = "halted"
+)
+
+// stopVM stops a running VM with the configured `stop_method`. A guest OS shutdown which does not complete within
+// `shutdown_timeout_seconds` falls back to a power-off.
+func stopVM(ctx context.Context, d *schema.ResourceData, meta interface{}, environmentID string, id string, timeout string) error {
+	vm, err := meta.(*SkytapClient).vmsClient.Get(ctx, environmentID, id)
+	if err != nil {
+		return fmt.Errorf("error retrieving VM (%s): %v", id, err)
+	}
+	if vm.Runstate == nil || *vm.Runstate != skytap.VMRunstateRunning {
+		return forceRunstate(ctx, meta, environmentID, id, skytap.VMRunstateStopped)
+	}
+
+	if d.Get("stop_method").(string) == vmStopMethodShutdown {
+		grace := time.Duration(d.Get("shutdown_timeout_seconds").(int)) * time.Second
+		err = requestVMStop(ctx, d, meta, environmentID, id, skytap.VMRunstateStopped, grace)
+		if err == nil {
+			return nil
+		}
+		log.Printf("[WARN] VM (%s) did not shut down within %s, powering it off: %v", id, grace, err)
+	}
+	return requestVMStop(ctx, d, meta, environmentID, id, skytap.VMRunstateHalted, d.Timeout(timeout))
+}
+
+// requestVMStop requests the runstate and waits for the VM to be stopped
+func requestVMStop(ctx context.Context, d *schema.ResourceData, meta interface{}, environmentID string, id string, runstate skytap.VMRunstate, timeout time.Duration) error {
+	log.Printf("[INFO] Changing VM (%s) runstate to %s", id, runstate)
+	_, err := meta.(*SkytapClient).vmManagementClient.UpdateRunstate(ctx, environmentID, id, runstate)
+	if err != nil {
+		return fmt.Errorf("error changing VM (%s) runstate to (%s): %v", id, runstate, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{string(skytap.VMRunstateRunning), string(skytap.VMRunstateBusy)},
+		Target:     []string{string(skytap.VMRunstateStopped)},
+		Refresh:    vmRunstateRefreshFunc(ctx, d, meta),
+		Timeout:    timeout,
+		MinTimeout: minTimeout * time.Second,
+		Delay:      delay * time.Second,
+	}
+
+	log.Printf("[INFO] Waiting for VM (%s) to stop", id)
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for VM (%s) to stop: %v", id, err)
+	}
+	log.Printf("[INFO] VM (%s) stopped", id)
+	return nil
+}
+
 func forceRunstate(ctx context.Context, meta interface{}, environmentID string, id string, runstate skytap.VMRunstate) error {
 	client := meta.(*SkytapClient).vmsClient
 
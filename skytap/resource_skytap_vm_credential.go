@@ -0,0 +1,143 @@
+package skytap
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func resourceSkytapVMCredential() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSkytapVMCredentialCreate,
+		ReadContext:   resourceSkytapVMCredentialRead,
+		UpdateContext: resourceSkytapVMCredentialUpdate,
+		DeleteContext: resourceSkytapVMCredentialDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vm_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "ID of the VM the credential is stored on",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"text": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				Description:  "The credential shown on the Credentials page of the VM, for example `username / password`",
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceSkytapVMCredentialCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).vmCredentialsClient
+
+	vmID := d.Get("vm_id").(string)
+
+	opts := VMTextRequest{
+		Text: utils.String(d.Get("text").(string)),
+	}
+
+	// the options are not traced as the text usually contains a password
+	log.Printf("[INFO] VM credential create")
+	credential, err := client.Create(ctx, vmID, &opts)
+	if err != nil {
+		return diag.Errorf("error creating credential on VM (%s): %v", vmID, err)
+	}
+
+	if credential.ID == nil {
+		return diag.Errorf("VM credential ID is not set")
+	}
+	d.SetId(*credential.ID)
+
+	log.Printf("[INFO] VM credential created: %s", *credential.ID)
+
+	return resourceSkytapVMCredentialRead(ctx, d, meta)
+}
+
+func resourceSkytapVMCredentialRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).vmCredentialsClient
+
+	vmID := d.Get("vm_id").(string)
+	id := d.Id()
+
+	log.Printf("[INFO] retrieving VM credential: %s", id)
+	credential, err := client.Get(ctx, vmID, id)
+	if err != nil {
+		if utils.ResponseErrorIsNotFound(err) {
+			log.Printf("[DEBUG] VM credential (%s) was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("error retrieving VM credential (%s): %v", id, err)
+	}
+
+	err = d.Set("text", credential.Text)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] VM credential retrieved: %s", id)
+
+	return nil
+}
+
+func resourceSkytapVMCredentialUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).vmCredentialsClient
+
+	vmID := d.Get("vm_id").(string)
+	id := d.Id()
+
+	opts := VMTextRequest{
+		Text: utils.String(d.Get("text").(string)),
+	}
+
+	log.Printf("[INFO] VM credential update: %s", id)
+	_, err := client.Update(ctx, vmID, id, &opts)
+	if err != nil {
+		return diag.Errorf("error updating VM credential (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] VM credential updated: %s", id)
+
+	return resourceSkytapVMCredentialRead(ctx, d, meta)
+}
+
+func resourceSkytapVMCredentialDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).vmCredentialsClient
+
+	vmID := d.Get("vm_id").(string)
+	id := d.Id()
+
+	log.Printf("[INFO] destroying VM credential: %s", id)
+	err := client.Delete(ctx, vmID, id)
+	if err != nil {
+		if utils.ResponseErrorIsNotFound(err) {
+			log.Printf("[DEBUG] VM credential (%s) was not found - assuming removed", id)
+			return nil
+		}
+
+		return diag.Errorf("error deleting VM credential (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] VM credential destroyed: %s", id)
+
+	return nil
+}
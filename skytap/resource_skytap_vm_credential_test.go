@@ -0,0 +1,69 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSkytapVMCredential_Basic(t *testing.T) {
+	templateID, vmID, newEnvTemplateID := setupEnvironment()
+	uniqueSuffixEnv := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSkytapEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSkytapVMCredentialConfig_basic(newEnvTemplateID, templateID, vmID, uniqueSuffixEnv, "trainee / first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapVMCredentialExists("skytap_vm_credential.login", "trainee / first"),
+				),
+			},
+			{
+				Config: testAccSkytapVMCredentialConfig_basic(newEnvTemplateID, templateID, vmID, uniqueSuffixEnv, "trainee / second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapVMCredentialExists("skytap_vm_credential.login", "trainee / second"),
+				),
+			},
+		},
+	})
+}
+
+// Verifies the VM credential exists with the expected text
+func testAccCheckSkytapVMCredentialExists(name string, text string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := getResource(s, name)
+		if err != nil {
+			return err
+		}
+
+		// retrieve the connection established in Provider configuration
+		client := testAccProvider.Meta().(*SkytapClient).vmCredentialsClient
+		ctx := context.TODO()
+
+		credential, err := client.Get(ctx, rs.Primary.Attributes["vm_id"], rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error retrieving VM credential (%s): %v", rs.Primary.ID, err)
+		}
+		if credential.Text == nil || *credential.Text != text {
+			return fmt.Errorf("VM credential (%s) does not have the expected text", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSkytapVMCredentialConfig_basic(envTemplateID string, templateID string, vmID string, uniqueSuffixEnv int, text string) string {
+	return testAccSkytapVMConfig_typical(envTemplateID, templateID, vmID, uniqueSuffixEnv, 8080, "", "") + fmt.Sprintf(`
+
+    resource "skytap_vm_credential" "login" {
+      vm_id = skytap_vm.cassandra1.id
+      text  = "%s"
+    }`, text)
+}
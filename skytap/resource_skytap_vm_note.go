@@ -0,0 +1,164 @@
+package skytap
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/skytap/skytap-sdk-go/skytap"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
+)
+
+func resourceSkytapVMNote() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSkytapVMNoteCreate,
+		ReadContext:   resourceSkytapVMNoteRead,
+		UpdateContext: resourceSkytapVMNoteUpdate,
+		DeleteContext: resourceSkytapVMNoteDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vm_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "ID of the VM the note is added to",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"text": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The text of the note",
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceSkytapVMNoteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).vmNotesClient
+
+	vmID := d.Get("vm_id").(string)
+
+	opts := VMTextRequest{
+		Text: utils.String(d.Get("text").(string)),
+	}
+
+	log.Printf("[INFO] VM note create")
+	log.Printf("[TRACE] VM note create options: %v", spew.Sdump(opts))
+	note, err := client.Create(ctx, vmID, &opts)
+	if err != nil {
+		return diag.Errorf("error creating note on VM (%s): %v", vmID, err)
+	}
+
+	if note.ID == nil {
+		return diag.Errorf("VM note ID is not set")
+	}
+	d.SetId(*note.ID)
+
+	log.Printf("[INFO] VM note created: %s", *note.ID)
+	log.Printf("[TRACE] VM note created: %v", spew.Sdump(note))
+
+	return resourceSkytapVMNoteRead(ctx, d, meta)
+}
+
+func resourceSkytapVMNoteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).vmNotesClient
+
+	vmID := d.Get("vm_id").(string)
+	id := d.Id()
+
+	log.Printf("[INFO] retrieving VM note: %s", id)
+	notes, err := client.List(ctx, vmID)
+	if err != nil {
+		if utils.ResponseErrorIsNotFound(err) {
+			log.Printf("[DEBUG] VM (%s) was not found - removing note (%s) from state", vmID, id)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("error retrieving notes of VM (%s): %v", vmID, err)
+	}
+
+	note := findVMNote(notes.Value, id)
+	if note == nil {
+		log.Printf("[DEBUG] VM note (%s) was not found - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	err = d.Set("text", note.Text)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] VM note retrieved: %s", id)
+	log.Printf("[TRACE] VM note retrieved: %v", spew.Sdump(note))
+
+	return nil
+}
+
+func resourceSkytapVMNoteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).vmNotesClient
+
+	vmID := d.Get("vm_id").(string)
+	id := d.Id()
+
+	opts := VMTextRequest{
+		Text: utils.String(d.Get("text").(string)),
+	}
+
+	log.Printf("[INFO] VM note update: %s", id)
+	log.Printf("[TRACE] VM note update options: %v", spew.Sdump(opts))
+	note, err := client.Update(ctx, vmID, id, &opts)
+	if err != nil {
+		return diag.Errorf("error updating VM note (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] VM note updated: %s", id)
+	log.Printf("[TRACE] VM note updated: %v", spew.Sdump(note))
+
+	return resourceSkytapVMNoteRead(ctx, d, meta)
+}
+
+func resourceSkytapVMNoteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*SkytapClient).vmNotesClient
+
+	vmID := d.Get("vm_id").(string)
+	id := d.Id()
+
+	log.Printf("[INFO] destroying VM note: %s", id)
+	err := client.Delete(ctx, vmID, id)
+	if err != nil {
+		if utils.ResponseErrorIsNotFound(err) {
+			log.Printf("[DEBUG] VM note (%s) was not found - assuming removed", id)
+			return nil
+		}
+
+		return diag.Errorf("error deleting VM note (%s): %v", id, err)
+	}
+
+	log.Printf("[INFO] VM note destroyed: %s", id)
+
+	return nil
+}
+
+func findVMNote(notes []skytap.Note, id string) *skytap.Note {
+	for i, note := range notes {
+		if note.ID != nil && *note.ID == id {
+			return &notes[i]
+		}
+	}
+	return nil
+}
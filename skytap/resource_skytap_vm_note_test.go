@@ -0,0 +1,75 @@
+package skytap
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSkytapVMNote_Basic(t *testing.T) {
+	templateID, vmID, newEnvTemplateID := setupEnvironment()
+	uniqueSuffixEnv := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSkytapEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSkytapVMNoteConfig_basic(newEnvTemplateID, templateID, vmID, uniqueSuffixEnv, "Log in as the training user"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapVMNoteExists("skytap_vm_note.note", "Log in as the training user"),
+				),
+			},
+			{
+				Config: testAccSkytapVMNoteConfig_basic(newEnvTemplateID, templateID, vmID, uniqueSuffixEnv, "Reset the lab before use"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapVMNoteExists("skytap_vm_note.note", "Reset the lab before use"),
+					resource.TestCheckResourceAttr("skytap_vm_note.note", "text", "Reset the lab before use"),
+				),
+			},
+		},
+	})
+}
+
+// Verifies the VM note exists with the expected text
+func testAccCheckSkytapVMNoteExists(name string, text string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := getResource(s, name)
+		if err != nil {
+			return err
+		}
+
+		// retrieve the connection established in Provider configuration
+		client := testAccProvider.Meta().(*SkytapClient).vmNotesClient
+		ctx := context.TODO()
+
+		notes, err := client.List(ctx, rs.Primary.Attributes["vm_id"])
+		if err != nil {
+			return fmt.Errorf("error retrieving VM notes: %v", err)
+		}
+
+		note := findVMNote(notes.Value, rs.Primary.ID)
+		if note == nil {
+			return fmt.Errorf("VM note (%s) was not found", rs.Primary.ID)
+		}
+		if note.Text == nil || *note.Text != text {
+			return fmt.Errorf("VM note (%s) does not have the expected text", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSkytapVMNoteConfig_basic(envTemplateID string, templateID string, vmID string, uniqueSuffixEnv int, text string) string {
+	return testAccSkytapVMConfig_typical(envTemplateID, templateID, vmID, uniqueSuffixEnv, 8080, "", "") + fmt.Sprintf(`
+
+    resource "skytap_vm_note" "note" {
+      vm_id = skytap_vm.cassandra1.id
+      text  = "%s"
+    }`, text)
+}
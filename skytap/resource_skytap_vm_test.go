@@ -59,6 +59,21 @@ func testSweepSkytapVM(region string) error {
 	return nil
 }
 
+func TestResourceSkytapVMStateUpgradeV0(t *testing.T) {
+	rawState := map[string]interface{}{
+		"name": "vm",
+		"disk": []interface{}{
+			map[string]interface{}{"id": "disk-1-1-scsi-0-1", "name": "data", "size": 5120, "type": "SCSI"},
+		},
+	}
+
+	upgraded, err := resourceSkytapVMStateUpgradeV0(context.Background(), rawState, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "vm", upgraded["name"])
+	assert.Equal(t, "", upgraded["disk"].([]interface{})[0].(map[string]interface{})["type"])
+	assert.Equal(t, "disk-1-1-scsi-0-1", upgraded["disk"].([]interface{})[0].(map[string]interface{})["id"])
+}
+
 func TestAccSkytapVM_Basic(t *testing.T) {
 	templateID, vmID, newEnvTemplateID := setupEnvironment()
 	uniqueSuffixEnv := acctest.RandInt()
@@ -81,6 +96,29 @@ func TestAccSkytapVM_Basic(t *testing.T) {
 	})
 }
 
+func TestAccSkytapVM_Copy(t *testing.T) {
+	templateID, vmID, newEnvTemplateID := setupEnvironment()
+	uniqueSuffixEnv := acctest.RandInt()
+	var vm skytap.VM
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSkytapEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSkytapVMConfig_copy(newEnvTemplateID, uniqueSuffixEnv, templateID, vmID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapVMExists("skytap_environment.target", "skytap_vm.copy", &vm),
+					resource.TestCheckResourceAttr("skytap_vm.copy", "name", "copy"),
+					resource.TestCheckResourceAttrPair("skytap_vm.copy", "source_environment_id", "skytap_environment.foo", "id"),
+					testAccCheckSkytapVMRunning(&vm),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSkytapVM_Timeout(t *testing.T) {
 	templateID, vmID, newEnvTemplateID := setupEnvironment()
 
@@ -122,7 +160,9 @@ func TestAccSkytapVM_Update(t *testing.T) {
 				Config: testAccSkytapVMConfig_basic(newEnvTemplateID, uniqueSuffixEnv, "", templateID, vmID,
 					fmt.Sprintf("name = \"tftest-vm-%d\"", uniqueSuffixVM), "", ``),
 				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapVMExists("skytap_environment.foo", "skytap_vm.bar", &vm),
 					resource.TestCheckResourceAttr("skytap_vm.bar", "name", fmt.Sprintf("tftest-vm-%d", uniqueSuffixVM)),
+					resource.TestCheckResourceAttr("skytap_vm.bar", "power_cycle_attributes.#", "0"),
 					testAccCheckSkytapVMRunning(&vm),
 				),
 			},
@@ -226,6 +266,84 @@ func TestAccSkytapVM_Interface(t *testing.T) {
 	})
 }
 
+func TestAccSkytapVM_InterfaceType(t *testing.T) {
+	templateID, vmID, newEnvTemplateID := setupEnvironment()
+	uniqueSuffixEnv := acctest.RandInt()
+	var vm skytap.VM
+	var mac string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSkytapEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSkytapVMConfig_interfaceType(newEnvTemplateID, uniqueSuffixEnv, templateID, vmID, "e1000"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapVMExists("skytap_environment.foo", "skytap_vm.bar", &vm),
+					testAccCheckSkytapInterfaceAttributes(t, "skytap_environment.foo", "skytap_network.baz", &vm, skytap.NICTypeE1000, []string{"192.168.0.10"}, []string{"bloggs-web"}),
+					resource.TestCheckTypeSetElemNestedAttrs("skytap_vm.bar", "network_interface.*", map[string]string{
+						"network_name": "tftest-network-1",
+						"network_type": "automatic",
+					}),
+					testAccCheckSkytapVMInterfaceMAC("skytap_vm.bar", &mac),
+				),
+			},
+			{
+				PreConfig: pause(MINUTES),
+				Config:    testAccSkytapVMConfig_interfaceType(newEnvTemplateID, uniqueSuffixEnv, templateID, vmID, "vmxnet3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapVMExists("skytap_environment.foo", "skytap_vm.bar", &vm),
+					testAccCheckSkytapInterfaceAttributes(t, "skytap_environment.foo", "skytap_network.baz", &vm, skytap.NICTypeVMXNet3, []string{"192.168.0.10"}, []string{"bloggs-web"}),
+					testAccCheckSkytapVMInterfaceMAC("skytap_vm.bar", &mac),
+				),
+			},
+		},
+	})
+}
+
+// Records the MAC address of the single network interface of the VM, or verifies it is unchanged
+func testAccCheckSkytapVMInterfaceMAC(name string, mac *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := getResource(s, name)
+		if err != nil {
+			return err
+		}
+
+		var current string
+		for key, value := range rs.Primary.Attributes {
+			if strings.HasPrefix(key, "network_interface.") && strings.HasSuffix(key, ".mac") {
+				current = value
+			}
+		}
+		if current == "" {
+			return fmt.Errorf("no MAC address found for the network interface of VM (%s)", rs.Primary.ID)
+		}
+		if *mac == "" {
+			*mac = current
+		} else if *mac != current {
+			return fmt.Errorf("the MAC address of the network interface changed from (%s) to (%s)", *mac, current)
+		}
+		return nil
+	}
+}
+
+func testAccSkytapVMConfig_interfaceType(envTemplateID string, uniqueSuffixEnv int, templateID string, vmID string, interfaceType string) string {
+	return testAccSkytapVMConfig_basic(envTemplateID, uniqueSuffixEnv, `
+		resource "skytap_network" "baz" {
+		  name           = "tftest-network-1"
+		  domain         = "mydomain.com"
+		  environment_id = skytap_environment.foo.id
+		  subnet         = "192.168.0.0/16"
+		}`, templateID, vmID, "name = \"test\"", fmt.Sprintf(`
+		network_interface {
+		  interface_type = "%s"
+		  network_id     = skytap_network.baz.id
+		  ip             = "192.168.0.10"
+		  hostname       = "bloggs-web"
+		}`, interfaceType), ``)
+}
+
 func TestAccSkytapVM_PublishedService(t *testing.T) {
 	templateID, vmID, newEnvTemplateID := setupEnvironment()
 	uniqueSuffixEnv := acctest.RandInt()
@@ -465,6 +583,197 @@ func TestAccSkytapVM_Typical(t *testing.T) {
 	})
 }
 
+func TestAccSkytapVM_HardwareSettings(t *testing.T) {
+	templateID, vmID, newEnvTemplateID := setupEnvironment()
+	uniqueSuffixEnv := acctest.RandInt()
+	var vm skytap.VM
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSkytapEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSkytapVMConfig_basic(newEnvTemplateID, uniqueSuffixEnv, "", templateID, vmID, "name = \"test\"", "",
+					`cpus = 2
+					cpus_per_socket = 2
+					nested_virtualization = true
+					copy_paste_enabled = false`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapVMExists("skytap_environment.foo", "skytap_vm.bar", &vm),
+					resource.TestCheckResourceAttr("skytap_vm.bar", "cpus_per_socket", "2"),
+					resource.TestCheckResourceAttr("skytap_vm.bar", "nested_virtualization", "true"),
+					resource.TestCheckResourceAttr("skytap_vm.bar", "copy_paste_enabled", "false"),
+					resource.TestCheckResourceAttrSet("skytap_vm.bar", "guest_os"),
+					testAccCheckSkytapVMRunning(&vm),
+				),
+			},
+			{
+				PreConfig: pause(MINUTES),
+				Config: testAccSkytapVMConfig_basic(newEnvTemplateID, uniqueSuffixEnv, "", templateID, vmID, "name = \"test\"", "",
+					`cpus = 2
+					cpus_per_socket = 1
+					nested_virtualization = false
+					copy_paste_enabled = true`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapVMExists("skytap_environment.foo", "skytap_vm.bar", &vm),
+					resource.TestCheckResourceAttr("skytap_vm.bar", "cpus_per_socket", "1"),
+					resource.TestCheckResourceAttr("skytap_vm.bar", "nested_virtualization", "false"),
+					resource.TestCheckResourceAttr("skytap_vm.bar", "copy_paste_enabled", "true"),
+					testAccCheckSkytapVMRunning(&vm),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSkytapVM_HardwareVersion(t *testing.T) {
+	templateID, vmID, newEnvTemplateID := setupEnvironment()
+	uniqueSuffixEnv := acctest.RandInt()
+	var vm skytap.VM
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSkytapEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSkytapVMConfig_basic(newEnvTemplateID, uniqueSuffixEnv, "", templateID, vmID, "name = \"test\"", "", ``),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapVMExists("skytap_environment.foo", "skytap_vm.bar", &vm),
+					resource.TestCheckResourceAttrSet("skytap_vm.bar", "hardware_version"),
+					resource.TestCheckResourceAttrSet("skytap_vm.bar", "max_hardware_version"),
+				),
+			},
+			{
+				Config:      testAccSkytapVMConfig_basic(newEnvTemplateID, uniqueSuffixEnv, "", templateID, vmID, "name = \"test\"", "", `hardware_version = 1`),
+				ExpectError: regexp.MustCompile(`cannot downgrade the hardware version`),
+			},
+		},
+	})
+}
+
+func TestAccSkytapVM_StopMethod(t *testing.T) {
+	templateID, vmID, newEnvTemplateID := setupEnvironment()
+	uniqueSuffixEnv := acctest.RandInt()
+	var vm skytap.VM
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSkytapEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSkytapVMConfig_basic(newEnvTemplateID, uniqueSuffixEnv, "", templateID, vmID, "name = \"test\"", "",
+					`stop_method = "halted"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapVMExists("skytap_environment.foo", "skytap_vm.bar", &vm),
+					resource.TestCheckResourceAttr("skytap_vm.bar", "stop_method", "halted"),
+					testAccCheckSkytapVMRunning(&vm),
+				),
+			},
+			{
+				PreConfig: pause(MINUTES),
+				Config: testAccSkytapVMConfig_basic(newEnvTemplateID, uniqueSuffixEnv, "", templateID, vmID, "name = \"test\"", "",
+					`stop_method = "halted"
+					cpus = 1`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapVMExists("skytap_environment.foo", "skytap_vm.bar", &vm),
+					testAccCheckSkytapVMCPU(t, &vm, 1),
+					testAccCheckSkytapVMRunning(&vm),
+				),
+			},
+			{
+				PreConfig: pause(MINUTES),
+				Config: testAccSkytapVMConfig_basic(newEnvTemplateID, uniqueSuffixEnv, "", templateID, vmID, "name = \"test\"", "",
+					`shutdown_timeout_seconds = 0
+					cpus = 2`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapVMExists("skytap_environment.foo", "skytap_vm.bar", &vm),
+					testAccCheckSkytapVMCPU(t, &vm, 2),
+					testAccCheckSkytapVMRunning(&vm),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSkytapVM_AllowStopForUpdate(t *testing.T) {
+	templateID, vmID, newEnvTemplateID := setupEnvironment()
+	uniqueSuffixEnv := acctest.RandInt()
+	var vm skytap.VM
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSkytapEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSkytapVMConfig_basic(newEnvTemplateID, uniqueSuffixEnv, "", templateID, vmID, "name = \"test\"", "",
+					`allow_stop_for_update = false`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapVMExists("skytap_environment.foo", "skytap_vm.bar", &vm),
+					resource.TestCheckResourceAttr("skytap_vm.bar", "allow_stop_for_update", "false"),
+					testAccCheckSkytapVMRunning(&vm),
+				),
+			},
+			{
+				Config: testAccSkytapVMConfig_basic(newEnvTemplateID, uniqueSuffixEnv, "", templateID, vmID, "name = \"test\"", "",
+					`allow_stop_for_update = false
+					cpus = 2`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`changing cpus requires VM \([0-9]+\) to be stopped, which is not allowed as 'allow_stop_for_update' is false`),
+			},
+			{
+				Config: testAccSkytapVMConfig_basic(newEnvTemplateID, uniqueSuffixEnv, "", templateID, vmID, "name = \"renamed\"", "",
+					`allow_stop_for_update = false`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapVMExists("skytap_environment.foo", "skytap_vm.bar", &vm),
+					resource.TestCheckResourceAttr("skytap_vm.bar", "name", "renamed"),
+					testAccCheckSkytapVMRunning(&vm),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSkytapVM_Power(t *testing.T) {
+	templateID, vmID, _ := setupNonDefaultEnvironment("SKYTAP_TEMPLATE_POWER_ID", "", "SKYTAP_VM_POWER_ID", "")
+	if templateID == "" || vmID == "" {
+		t.Skip("SKYTAP_TEMPLATE_POWER_ID and SKYTAP_VM_POWER_ID must be set to test IBM Power VMs")
+	}
+	uniqueSuffixEnv := acctest.RandInt()
+	var vm skytap.VM
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSkytapEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSkytapVMConfig_basic(templateID, uniqueSuffixEnv, "", templateID, vmID, "name = \"power\"", "", ``),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapVMExists("skytap_environment.foo", "skytap_vm.bar", &vm),
+					resource.TestCheckResourceAttr("skytap_vm.bar", "architecture", "power"),
+					resource.TestCheckResourceAttrSet("skytap_vm.bar", "instance_type"),
+				),
+			},
+			{
+				Config: testAccSkytapVMConfig_basic(templateID, uniqueSuffixEnv, "", templateID, vmID, "name = \"power\"", "",
+					`nested_virtualization = true`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`the 'nested_virtualization' argument is not supported by IBM Power VMs`),
+			},
+			{
+				Config: testAccSkytapVMConfig_basic(templateID, uniqueSuffixEnv, "", templateID, vmID, "name = \"power\"", "",
+					`os_disk_size = 40960`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`the 'os_disk_size' argument is not supported by IBM Power VMs`),
+			},
+		},
+	})
+}
+
 func TestAccSkytapVMCPURam_Create(t *testing.T) {
 	templateID, vmID, newEnvTemplateID := setupEnvironment()
 	uniqueSuffixEnv := acctest.RandInt()
@@ -500,9 +809,17 @@ func TestAccSkytapVMCPURam_Create(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckSkytapVMExists("skytap_environment.foo", "skytap_vm.bar", &vm),
 					resource.TestCheckResourceAttr("skytap_vm.bar", "cpus", "1"),
+					resource.TestCheckResourceAttr("skytap_vm.bar", "power_cycle_attributes.#", "1"),
+					resource.TestCheckTypeSetElemAttr("skytap_vm.bar", "power_cycle_attributes.*", "cpus"),
 					testAccCheckSkytapVMCPU(t, &vm, 1),
 				),
 			},
+			{
+				// the power cycle attributes of the applied plan are cleared by the refresh
+				Config: testAccSkytapVMConfig_basic(newEnvTemplateID, uniqueSuffixEnv, "", templateID, vmID, "name = \"test\"", "",
+					`cpus = 1`),
+				Check: resource.TestCheckResourceAttr("skytap_vm.bar", "power_cycle_attributes.#", "0"),
+			},
 			{
 				PreConfig: pause(MINUTES),
 				Config: testAccSkytapVMConfig_basic(newEnvTemplateID, uniqueSuffixEnv, "", templateID, vmID, "name = \"test\"", "",
@@ -648,6 +965,7 @@ func TestAccSkytapVMCPU_OutOfRange(t *testing.T) {
 				Config: testAccSkytapVMConfig_basic(newEnvTemplateID, uniqueSuffixEnv, "", templateID, vmID, "", "",
 					`cpus = 12
                               ram = 131072`),
+				PlanOnly:    true,
 				ExpectError: regexp.MustCompile(`the 'cpus' argument has been assigned \(12\) which is more than the maximum allowed \(8\) as defined by this VM`),
 			},
 		},
@@ -826,6 +1144,65 @@ func TestAccSkytapVMDisks_Resize(t *testing.T) {
 	})
 }
 
+func TestAccSkytapVMDisks_TypeAndRemove(t *testing.T) {
+	templateID, vmID, newEnvTemplateID := setupEnvironment()
+	uniqueSuffixEnv := acctest.RandInt()
+	var vm skytap.VM
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSkytapEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSkytapVMConfig_basic(newEnvTemplateID, uniqueSuffixEnv, "", templateID, vmID, "", "",
+					`disk {
+						size = 4096
+						name = "scsi"
+					}
+					disk {
+						size = 8192
+						name = "sata"
+						type = "SATA"
+					}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapVMExists("skytap_environment.foo", "skytap_vm.bar", &vm),
+					testAccCheckSkytapVMDiskResource(t, "skytap_vm.bar", "2", []string{"scsi", "sata"}),
+					resource.TestCheckTypeSetElemNestedAttrs("skytap_vm.bar", "disk.*", map[string]string{
+						"name": "sata",
+						"type": "SATA",
+					}),
+					testAccCheckSkytapVMDisks(t, &vm, []int{4096, 8192}),
+				),
+			},
+			{
+				PreConfig: pause(MINUTES),
+				Config: testAccSkytapVMConfig_basic(newEnvTemplateID, uniqueSuffixEnv, "", templateID, vmID, "", "",
+					`disk {
+						size = 8192
+						name = "sata"
+						type = "SATA"
+					}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSkytapVMExists("skytap_environment.foo", "skytap_vm.bar", &vm),
+					testAccCheckSkytapVMDiskResource(t, "skytap_vm.bar", "1", []string{"sata"}),
+					testAccCheckSkytapVMDisks(t, &vm, []int{8192}),
+				),
+			},
+			{
+				Config: testAccSkytapVMConfig_basic(newEnvTemplateID, uniqueSuffixEnv, "", templateID, vmID, "", "",
+					`disk {
+						size = 8192
+						name = "sata"
+						type = "SCSI"
+					}`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`the type of disk \(sata\) cannot be changed from \(SATA\) to \(SCSI\)`),
+			},
+		},
+	})
+}
+
 func TestAccSkytapVMDisk_Invalid(t *testing.T) {
 	templateID, vmID, newEnvTemplateID := setupEnvironment()
 	uniqueSuffixEnv := acctest.RandInt()
@@ -1321,6 +1698,23 @@ func testAccSkytapVMConfig_basic(envTemplateID string, uniqueSuffixEnv int, netw
 	return config
 }
 
+func testAccSkytapVMConfig_copy(envTemplateID string, uniqueSuffixEnv int, VMTemplateID string, VMID string) string {
+	return testAccSkytapVMConfig_basic(envTemplateID, uniqueSuffixEnv, "", VMTemplateID, VMID, "name = \"donor\"", "", "") + fmt.Sprintf(`
+ 	resource "skytap_environment" "target" {
+ 		template_id = "%s"
+ 		name 		= "%s-environment-%d-target"
+ 		description = "This is an environment to support a vm skytap terraform provider acceptance test"
+ 	}
+
+ 	resource "skytap_vm" "copy" {
+		environment_id        = skytap_environment.target.id
+		source_environment_id = skytap_environment.foo.id
+		vm_id                 = skytap_vm.bar.id
+		name                  = "copy"
+ 	}
+ `, envTemplateID, vmEnvironmentPrefix, uniqueSuffixEnv)
+}
+
 func testAccSkytapVMConfigBlock(envTemplateID string, uniqueSuffixEnv int, VMTemplateID string,
 	VMID string, name string, requirements string, block string) string {
 
@@ -1484,7 +1878,7 @@ func testAccCheckSkytapVMUpdated(t *testing.T, vm *skytap.VM, vm2 *skytap.VM) re
 
 func testAccCheckSkytapVMOSDisk(t *testing.T, vm *skytap.VM, size int) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		assert.Equal(t, size, *vm.Hardware.Disks[0].Size)
+		assert.Equal(t, size, *vm.Hardware.Disks[osDiskIndex(vm.Hardware.Disks)].Size)
 		return nil
 	}
 }
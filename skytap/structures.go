@@ -2,12 +2,15 @@ package skytap
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/skytap/skytap-sdk-go/skytap"
 
 	"github.com/terraform-providers/terraform-provider-skytap/skytap/hashcode"
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
 )
 
 func flattenNetworkInterfaces(interfaces []skytap.Interface) []interface{} {
@@ -33,12 +36,69 @@ func flattenNetworkInterface(v skytap.Interface) map[string]interface{} {
 	if v.Hostname != nil {
 		result["hostname"] = *v.Hostname
 	}
+	if v.MAC != nil {
+		result["mac"] = *v.MAC
+	}
+	if v.Status != nil {
+		result["status"] = *v.Status
+	}
+	if v.NetworkName != nil {
+		result["network_name"] = *v.NetworkName
+	}
+	if v.NetworkType != nil {
+		result["network_type"] = *v.NetworkType
+	}
 	if len(v.Services) > 0 {
 		result["published_service"] = flattenPublishedServices(v.Services)
 	}
+	result["public_ip"] = flattenPublicIPAttachments(v.PublicIPAttachments)
 	return result
 }
 
+// retypedNetworkInterfaces pairs the removed and added network interfaces which only differ by type, removing them
+// from both sets. It returns the new type of each interface by ID.
+func retypedNetworkInterfaces(remove *schema.Set, add *schema.Set) map[string]string {
+	retyped := make(map[string]string)
+	for _, r := range remove.List() {
+		removed := r.(map[string]interface{})
+		for _, a := range add.List() {
+			added := a.(map[string]interface{})
+
+			candidate := make(map[string]interface{}, len(added))
+			for k, v := range added {
+				candidate[k] = v
+			}
+			candidate["interface_type"] = removed["interface_type"]
+			if remove.F(candidate) != remove.F(removed) {
+				continue
+			}
+
+			retyped[removed["id"].(string)] = added["interface_type"].(string)
+			remove.Remove(r)
+			add.Remove(a)
+			break
+		}
+	}
+	return retyped
+}
+
+func flattenPublicIPAttachments(attachments []skytap.PublicIPAttachment) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, v := range attachments {
+		result := make(map[string]interface{})
+		if v.Address != nil {
+			result["address"] = *v.Address
+		}
+		if v.DNSName != nil {
+			result["dns_name"] = *v.DNSName
+		}
+		results = append(results, result)
+	}
+
+	return results
+}
+
 func flattenPublishedServices(services []skytap.PublishedService) []interface{} {
 	results := make([]interface{}, 0)
 
@@ -61,12 +121,70 @@ func flattenPublishedService(v skytap.PublishedService) map[string]interface{} {
 	return result
 }
 
+func flattenEnvironmentVMs(vms []skytap.VM) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, v := range vms {
+		result := make(map[string]interface{})
+		result["id"] = *v.ID
+		if v.Name != nil {
+			result["name"] = *v.Name
+		}
+		if v.Runstate != nil {
+			result["runstate"] = string(*v.Runstate)
+		}
+		result["network_interface"] = flattenNetworkInterfaces(v.Interfaces)
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func flattenEnvironmentNetworks(networks []skytap.Network) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, v := range networks {
+		result := make(map[string]interface{})
+		result["id"] = *v.ID
+		if v.Name != nil {
+			result["name"] = *v.Name
+		}
+		if v.Subnet != nil {
+			result["subnet"] = *v.Subnet
+		}
+		if v.Gateway != nil {
+			result["gateway"] = *v.Gateway
+		}
+		if v.Domain != nil {
+			result["domain"] = *v.Domain
+		}
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func flattenSVMsByArchitecture(svms *skytap.SVMsByArchitecture) map[string]interface{} {
+	result := make(map[string]interface{})
+	if svms == nil {
+		return result
+	}
+	if svms.X86 != nil {
+		result["x86"] = *svms.X86
+	}
+	if svms.Power != nil {
+		result["power"] = *svms.Power
+	}
+	return result
+}
+
 func flattenDisks(disks []skytap.Disk) []interface{} {
 	results := make([]interface{}, 0)
 
-	for _, v := range disks {
-		// ignore os disk for now
-		if "0" != *v.LUN {
+	osDisk := osDiskIndex(disks)
+	for idx, v := range disks {
+		// the OS disk is managed with `os_disk_size`
+		if idx != osDisk {
 			results = append(results, flattenDisk(v))
 		}
 	}
@@ -74,6 +192,78 @@ func flattenDisks(disks []skytap.Disk) []interface{} {
 	return results
 }
 
+// osDiskIndex returns the index of the OS disk, the first disk attached at LUN 0, or -1 when the VM has none
+func osDiskIndex(disks []skytap.Disk) int {
+	for idx, disk := range disks {
+		if disk.LUN != nil && *disk.LUN == "0" {
+			return idx
+		}
+	}
+	return -1
+}
+
+// vmDiskIdentification identifies the data disks of the VM so a hardware update keeps them
+func vmDiskIdentification(vm *skytap.VM) []skytap.DiskIdentification {
+	diskIDs := make([]skytap.DiskIdentification, 0)
+	osDisk := osDiskIndex(vm.Hardware.Disks)
+	for idx, disk := range vm.Hardware.Disks {
+		// ignore os disk
+		if idx != osDisk {
+			diskIDs = append(diskIDs, skytap.DiskIdentification{ID: disk.ID, Name: disk.Name, Size: disk.Size})
+		}
+	}
+	return diskIDs
+}
+
+// nameVMDisks names the data disks of the VM. The disks already known are named after their ID, and the other disks
+// are matched to the added disk blocks by size and type.
+func nameVMDisks(disks []skytap.Disk, names map[string]string, added []interface{}) {
+	matched := make([]bool, len(added))
+	osDisk := osDiskIndex(disks)
+	for idx := range disks {
+		// ignore os disk
+		if idx == osDisk {
+			continue
+		}
+		if name, ok := names[*disks[idx].ID]; ok {
+			disks[idx].Name = utils.String(name)
+			continue
+		}
+		for i, disk := range added {
+			diskMap := disk.(map[string]interface{})
+			diskType, _ := diskMap["type"].(string)
+			if matched[i] || diskMap["size"].(int) != *disks[idx].Size ||
+				(diskType != "" && disks[idx].Type != nil && !strings.EqualFold(diskType, *disks[idx].Type)) {
+				continue
+			}
+			matched[i] = true
+			disks[idx].Name = utils.String(diskMap["name"].(string))
+			break
+		}
+	}
+}
+
+// diskHash identifies a disk block by its name, size and configured type. The type is only kept in the state when it
+// is configured, so a type left to the API does not cause a diff.
+func diskHash(v interface{}) int {
+	diskMap := v.(map[string]interface{})
+	if diskType, _ := diskMap["type"].(string); diskType != "" {
+		return hashcode.String(fmt.Sprintf("%s-%d-%s", diskMap["name"].(string), diskMap["size"].(int), diskType))
+	}
+	return hashcode.String(fmt.Sprintf("%s-%d", diskMap["name"].(string), diskMap["size"].(int)))
+}
+
+// clearDiskTypes blanks the type of the flattened disks whose type is not configured
+func clearDiskTypes(disks []interface{}, configured map[string]bool) []interface{} {
+	for _, disk := range disks {
+		diskMap := disk.(map[string]interface{})
+		if name, _ := diskMap["name"].(string); !configured[name] {
+			diskMap["type"] = ""
+		}
+	}
+	return disks
+}
+
 func flattenDisk(v skytap.Disk) map[string]interface{} {
 	result := make(map[string]interface{})
 	size := *v.Size
@@ -110,9 +300,11 @@ func flattenLabels(labels []*skytap.Label) []interface{} {
 }
 
 func flattenProjectIDs(projects []skytap.Project) []interface{} {
-	flattened := make([]interface{}, len(projects))
-	for i, v := range projects {
-		flattened[i] = v.ID
+	flattened := make([]interface{}, 0, len(projects))
+	for _, v := range projects {
+		if v.ID != nil {
+			flattened = append(flattened, strconv.Itoa(*v.ID))
+		}
 	}
 	return flattened
 }
@@ -125,6 +317,18 @@ func flattenProjectEnvironments(environments []skytap.ProjectEnvironment) []inte
 	return flattened
 }
 
+// flattenManagedProjectEnvironments only keeps the environments in managed so that memberships
+// added elsewhere, such as by skytap_project_environment, are ignored.
+func flattenManagedProjectEnvironments(environments []skytap.ProjectEnvironment, managed *schema.Set) []interface{} {
+	flattened := make([]interface{}, 0)
+	for _, v := range environments {
+		if managed.Contains(v.ID) {
+			flattened = append(flattened, v.ID)
+		}
+	}
+	return flattened
+}
+
 func getVMNetworkInterface(id string, vm *skytap.VM) (*skytap.Interface, error) {
 	for _, networkInterface := range vm.Interfaces {
 		if *networkInterface.ID == id {
@@ -164,3 +368,85 @@ func caseInsensitiveSuppress(_, old, new string, _ *schema.ResourceData) bool {
 func stringCaseSensitiveHash(v interface{}) int {
 	return hashcode.String(strings.ToLower(v.(string)))
 }
+
+func flattenScheduleActions(actions []ScheduleAction) []interface{} {
+	flattened := make([]interface{}, 0)
+	for _, v := range actions {
+		if v.Type == nil || v.Offset == nil {
+			continue
+		}
+		flattened = append(flattened, map[string]interface{}{
+			"type":   string(*v.Type),
+			"offset": *v.Offset,
+		})
+	}
+	return flattened
+}
+
+func flattenSharingPortalVMs(vms []SharingPortalVM) []interface{} {
+	flattened := make([]interface{}, 0)
+	for _, v := range vms {
+		if v.VMID == nil || v.Access == nil {
+			continue
+		}
+		vm := map[string]interface{}{
+			"vm_id":  *v.VMID,
+			"access": string(*v.Access),
+		}
+		if v.DesktopURL != nil {
+			vm["desktop_url"] = *v.DesktopURL
+		}
+		flattened = append(flattened, vm)
+	}
+	return flattened
+}
+
+func flattenStages(stages []skytap.Stage) []interface{} {
+	sorted := make([]skytap.Stage, len(stages))
+	copy(sorted, stages)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Index != nil && sorted[j].Index != nil && *sorted[i].Index < *sorted[j].Index
+	})
+
+	flattened := make([]interface{}, 0)
+	for _, v := range sorted {
+		stage := map[string]interface{}{
+			"vm_ids":                     v.VMIDs,
+			"delay_after_finish_seconds": 0,
+		}
+		if v.DelayAfterFinishSeconds != nil {
+			stage["delay_after_finish_seconds"] = *v.DelayAfterFinishSeconds
+		}
+		flattened = append(flattened, stage)
+	}
+	return flattened
+}
+
+// environmentResourceIDs returns the IDs of the VMs and of the networks of an environment
+func environmentResourceIDs(environment *skytap.Environment) (map[string]bool, map[string]bool) {
+	vmIDs := make(map[string]bool)
+	for _, vm := range environment.VMs {
+		if vm.ID != nil {
+			vmIDs[*vm.ID] = true
+		}
+	}
+	networkIDs := make(map[string]bool)
+	for _, network := range environment.Networks {
+		if network.ID != nil {
+			networkIDs[*network.ID] = true
+		}
+	}
+	return vmIDs, networkIDs
+}
+
+// addedIDs returns the sorted IDs in after which are not in before
+func addedIDs(before map[string]bool, after map[string]bool) []string {
+	added := make([]string, 0)
+	for id := range after {
+		if !before[id] {
+			added = append(added, id)
+		}
+	}
+	sort.Strings(added)
+	return added
+}
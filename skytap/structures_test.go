@@ -8,8 +8,11 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/skytap/skytap-sdk-go/skytap"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-skytap/skytap/utils"
 )
 
 func TestFlattenInterfaces(t *testing.T) {
@@ -40,6 +43,143 @@ func TestFlattenInterfaces(t *testing.T) {
 	}
 }
 
+func TestFlattenPublicIPAttachments(t *testing.T) {
+	var interfaces []skytap.Interface
+	err := json.Unmarshal(readTestFile(t, "vm_interface_public_ip_response.json"), &interfaces)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, flattenNetworkInterface(interfaces[0])["public_ip"], 0)
+
+	publicIPs := flattenNetworkInterface(interfaces[1])["public_ip"].([]interface{})
+	assert.Len(t, publicIPs, 1)
+	publicIP := publicIPs[0].(map[string]interface{})
+	assert.Equal(t, "35.162.10.20", publicIP["address"])
+	assert.Equal(t, "wins2016s2.skytap.example", publicIP["dns_name"])
+}
+
+func TestFlattenEnvironmentInventory(t *testing.T) {
+	var interfaces []skytap.Interface
+	err := json.Unmarshal(readTestFile(t, "vm_interface_response.json"), &interfaces)
+	if err != nil {
+		t.Fatal(err)
+	}
+	runstate := skytap.VMRunstateRunning
+	vms := flattenEnvironmentVMs([]skytap.VM{{ID: utils.String("1"), Name: utils.String("one"), Runstate: &runstate, Interfaces: interfaces}})
+	networks := flattenEnvironmentNetworks([]skytap.Network{{ID: utils.String("10"), Name: utils.String("dev"), Subnet: utils.String("10.0.0.0/24")}})
+
+	d := resourceSkytapEnvironment().TestResourceData()
+	assert.NoError(t, d.Set("vms", vms))
+	assert.NoError(t, d.Set("networks", networks))
+
+	assert.Equal(t, "one", d.Get("vms.0.name"))
+	assert.Equal(t, "running", d.Get("vms.0.runstate"))
+	assert.Equal(t, len(interfaces), d.Get("vms.0.network_interface.#"))
+	assert.Equal(t, "00:50:56:11:7D:D9", d.Get("vms.0.network_interface.0.mac"))
+	assert.Equal(t, "tftest-network-1", d.Get("vms.0.network_interface.0.network_name"))
+	assert.Equal(t, "dev", d.Get("networks.0.name"))
+	assert.Equal(t, "10.0.0.0/24", d.Get("networks.0.subnet"))
+	assert.Equal(t, "", d.Get("networks.0.gateway"))
+}
+
+func TestRetypedNetworkInterfaces(t *testing.T) {
+	nicSchema := resourceSkytapVM().Schema["network_interface"].Elem.(*schema.Resource)
+	networkInterface := func(id string, nicType string, ip string) map[string]interface{} {
+		return map[string]interface{}{
+			"id": id, "interface_type": nicType, "network_id": "net-1", "ip": ip, "hostname": "host",
+			"published_service": schema.NewSet(schema.HashResource(nicSchema.Schema["published_service"].Elem.(*schema.Resource)), nil),
+		}
+	}
+	hash := schema.HashResource(nicSchema)
+	remove := schema.NewSet(hash, []interface{}{networkInterface("nic-1", "e1000", "10.0.0.1"), networkInterface("nic-2", "e1000", "10.0.0.2")})
+	add := schema.NewSet(hash, []interface{}{networkInterface("", "vmxnet3", "10.0.0.1"), networkInterface("", "vmxnet3", "10.0.0.3")})
+
+	retyped := retypedNetworkInterfaces(remove, add)
+	assert.Equal(t, map[string]string{"nic-1": "vmxnet3"}, retyped)
+	assert.Equal(t, 1, remove.Len())
+	assert.Equal(t, "nic-2", remove.List()[0].(map[string]interface{})["id"])
+	assert.Equal(t, 1, add.Len())
+	assert.Equal(t, "10.0.0.3", add.List()[0].(map[string]interface{})["ip"])
+}
+
+func TestFlattenProjectIDs(t *testing.T) {
+	projects := []skytap.Project{{ID: utils.Int(1)}, {}, {ID: utils.Int(3)}}
+
+	assert.Equal(t, []interface{}{"1", "3"}, flattenProjectIDs(projects))
+}
+
+func TestFlattenSVMsByArchitecture(t *testing.T) {
+	svms := skytap.SVMsByArchitecture{X86: utils.Int(4), Power: utils.Int(2)}
+
+	assert.Equal(t, map[string]interface{}{"x86": 4, "power": 2}, flattenSVMsByArchitecture(&svms))
+	assert.Empty(t, flattenSVMsByArchitecture(nil))
+}
+
+func TestFlattenManagedProjectEnvironments(t *testing.T) {
+	environments := []skytap.ProjectEnvironment{{ID: "1"}, {ID: "2"}, {ID: "3"}}
+	managed := schema.NewSet(schema.HashString, []interface{}{"1", "3", "4"})
+
+	assert.Equal(t, []interface{}{"1", "3"}, flattenManagedProjectEnvironments(environments, managed))
+	assert.Empty(t, flattenManagedProjectEnvironments(environments, schema.NewSet(schema.HashString, nil)))
+}
+
+func TestEnvironmentResourceIDs(t *testing.T) {
+	environment := skytap.Environment{
+		VMs:      []skytap.VM{{ID: utils.String("1")}, {ID: utils.String("2")}, {ID: utils.String("3")}},
+		Networks: []skytap.Network{{ID: utils.String("10")}, {ID: utils.String("11")}},
+	}
+	vmIDs, networkIDs := environmentResourceIDs(&environment)
+
+	assert.Equal(t, []string{"2", "3"}, addedIDs(map[string]bool{"1": true}, vmIDs))
+	assert.Equal(t, []string{}, addedIDs(networkIDs, networkIDs))
+	assert.Equal(t, []string{"3"}, remainingIDs(schema.NewSet(schema.HashString, []interface{}{"3", "4"}), vmIDs))
+}
+
+func TestMergedResourceIDs(t *testing.T) {
+	vm := func(id string, name string) skytap.VM {
+		return skytap.VM{ID: utils.String(id), Name: utils.String(name)}
+	}
+	network := func(id string, name string) skytap.Network {
+		return skytap.Network{ID: utils.String(id), Name: utils.String(name)}
+	}
+	before := map[string]bool{"1": true, "10": true}
+
+	// VM 4 and network 12 are added concurrently by another merge
+	vms := []skytap.VM{vm("1", "web"), vm("2", "web"), vm("3", "db"), vm("4", "web"), vm("5", "other")}
+	assert.Equal(t, []string{"2", "3"}, mergedVMIDs(before, vms, []skytap.VM{vm("100", "web"), vm("101", "db")}))
+	assert.Equal(t, []string{}, mergedVMIDs(before, vms, []skytap.VM{vm("100", "missing")}))
+
+	networks := []skytap.Network{network("10", "dev"), network("11", "dev"), network("12", "prod")}
+	assert.Equal(t, []string{"11"}, mergedNetworkIDs(before, networks, []skytap.Network{network("200", "dev")}))
+}
+
+func TestFlattenStages(t *testing.T) {
+	stages := []skytap.Stage{
+		{Index: utils.Int(1), DelayAfterFinishSeconds: utils.Int(0), VMIDs: []string{"3", "4"}},
+		{Index: utils.Int(0), DelayAfterFinishSeconds: utils.Int(120), VMIDs: []string{"1"}},
+	}
+
+	flattened := flattenStages(stages)
+
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"vm_ids": []string{"1"}, "delay_after_finish_seconds": 120},
+		map[string]interface{}{"vm_ids": []string{"3", "4"}, "delay_after_finish_seconds": 0},
+	}, flattened)
+}
+
+func TestEnvironmentStagedVMIDs(t *testing.T) {
+	environment := skytap.Environment{
+		SequencingEnabled: utils.Bool(false),
+		Stages:            []skytap.Stage{{Index: utils.Int(0), VMIDs: []string{"1"}}},
+	}
+	assert.Nil(t, environmentStagedVMIDs(&environment))
+
+	environment.SequencingEnabled = utils.Bool(true)
+	environment.Stages = append(environment.Stages, skytap.Stage{Index: utils.Int(1), VMIDs: []string{"2", "3"}})
+	assert.Equal(t, map[string]bool{"1": true, "2": true, "3": true}, environmentStagedVMIDs(&environment))
+}
+
 func TestFlattenPublishedServices(t *testing.T) {
 
 	response := string(readTestFile(t, "vm_interface_services_response.json"))
@@ -111,6 +251,81 @@ func TestFlattenDisks(t *testing.T) {
 	}
 }
 
+func TestVMDiskIdentification(t *testing.T) {
+	var disks []skytap.Disk
+	err := json.Unmarshal(readTestFile(t, "vm_disks.json"), &disks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vm := skytap.VM{Hardware: &skytap.Hardware{Disks: disks}}
+
+	diskIDs := vmDiskIdentification(&vm)
+	assert.Len(t, diskIDs, 3)
+	assert.Equal(t, "disk-1-1-scsi-0-1", *diskIDs[0].ID)
+	assert.Equal(t, 5121, *diskIDs[1].Size)
+	assert.Equal(t, "three", *diskIDs[2].Name)
+}
+
+func TestOSDiskIndex(t *testing.T) {
+	var disks []skytap.Disk
+	err := json.Unmarshal(readTestFile(t, "vm_disks.json"), &disks)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the OS disk is found by its LUN
+	assert.Equal(t, 1, osDiskIndex(disks))
+	assert.Equal(t, -1, osDiskIndex(disks[2:]))
+}
+
+func TestNameVMDisks(t *testing.T) {
+	var disks []skytap.Disk
+	err := json.Unmarshal(readTestFile(t, "vm_disks.json"), &disks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for idx := range disks {
+		disks[idx].Name = nil
+	}
+
+	added := []interface{}{
+		map[string]interface{}{"name": "sata", "size": 5120, "type": "SATA"},
+		map[string]interface{}{"name": "added", "size": 5120, "type": ""},
+	}
+	nameVMDisks(disks, map[string]string{"disk-1-1-scsi-0-2": "kept"}, added)
+
+	// the disk at LUN 0 is the OS disk
+	assert.Nil(t, disks[1].Name)
+	flattened := flattenDisks(disks)
+	assert.Len(t, flattened, 3)
+	assert.Equal(t, "added", flattened[0].(map[string]interface{})["name"])
+	assert.Equal(t, "kept", flattened[1].(map[string]interface{})["name"])
+	assert.Equal(t, "sata", flattened[2].(map[string]interface{})["name"])
+}
+
+func TestDiskHash(t *testing.T) {
+	disk := map[string]interface{}{"name": "data", "size": 5120, "type": ""}
+	typed := map[string]interface{}{"name": "data", "size": 5120, "type": "SCSI"}
+	retyped := map[string]interface{}{"name": "data", "size": 5120, "type": "SATA"}
+
+	assert.NotEqual(t, diskHash(disk), diskHash(typed))
+	assert.NotEqual(t, diskHash(typed), diskHash(retyped))
+	assert.Equal(t, diskHash(typed), diskHash(map[string]interface{}{"name": "data", "size": 5120, "type": "SCSI", "id": "1"}))
+}
+
+func TestClearDiskTypes(t *testing.T) {
+	var disks []skytap.Disk
+	err := json.Unmarshal(readTestFile(t, "vm_disks.json"), &disks)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	flattened := clearDiskTypes(flattenDisks(disks), map[string]bool{"three": true})
+	assert.Equal(t, "", flattened[0].(map[string]interface{})["type"])
+	assert.Equal(t, "", flattened[1].(map[string]interface{})["type"])
+	assert.Equal(t, "SATA", flattened[2].(map[string]interface{})["type"])
+}
+
 func readTestFile(t *testing.T, name string) []byte {
 	path := filepath.Join("testdata", name) // relative path
 	bytes, err := ioutil.ReadFile(path)
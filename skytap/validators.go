@@ -5,9 +5,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/skytap/skytap-sdk-go/skytap"
+	"regexp"
 	"strings"
 )
 
+var dateTimeRegexp = regexp.MustCompile(`^\d{4}/\d{2}/\d{2} \d{2}:\d{2}:\d{2}$`)
+
 // FIXME: update validators to schema.SchemaValidateDiagFunc when the validation helper package supports it better
 // By this I mean that currently all of the builtin validators require validation.ToDiagFunc() to convert them, but even
 // worse: the validation.All() and validation.Any() functions accept schema.SchemaValidateFunc, which means that all
@@ -33,6 +36,33 @@ func validateRoleType() schema.SchemaValidateFunc {
 	}, false)
 }
 
+func validateScheduleActionType() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		string(ScheduleActionTypeRun),
+		string(ScheduleActionTypeSuspend),
+		string(ScheduleActionTypeShutdown),
+		string(ScheduleActionTypePowerOff),
+	}, false)
+}
+
+func validateScheduleRecurringDay() schema.SchemaValidateFunc {
+	return validation.StringInSlice(scheduleRecurringDays, false)
+}
+
+func validateSharingPortalAccess() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		string(SharingPortalAccessRunAndUse),
+		string(SharingPortalAccessUse),
+		string(SharingPortalAccessRunAndViewOnly),
+		string(SharingPortalAccessViewOnly),
+	}, false)
+}
+
+// validateDateTime validates the yyyy/mm/dd hh:mm:ss format of the date and time arguments of the Skytap API
+func validateDateTime() schema.SchemaValidateFunc {
+	return validation.StringMatch(dateTimeRegexp, "format must be yyyy/mm/dd hh:mm:ss")
+}
+
 func validateNoSubString(subString string) schema.SchemaValidateFunc {
 	return func(i interface{}, k string) (s []string, es []error) {
 		v, ok := i.(string)
@@ -29,6 +29,63 @@ func TestValidateNICType(t *testing.T) {
 	}
 }
 
+func TestValidateScheduleActionType(t *testing.T) {
+	x := []StringValidationTestCase{
+		// No errors
+		{TestName: "run", Value: string(ScheduleActionTypeRun)},
+		{TestName: "suspend", Value: string(ScheduleActionTypeSuspend)},
+		{TestName: "shutdown", Value: string(ScheduleActionTypeShutdown)},
+		{TestName: "poweroff", Value: string(ScheduleActionTypePowerOff)},
+
+		// With errors
+		{TestName: "empty", Value: "", ExpectError: true},
+		{TestName: "unexpected", Value: "Foobar", ExpectError: true},
+	}
+
+	es := testStringValidationCases(x, validateScheduleActionType())
+	if len(es) > 0 {
+		t.Errorf("Failed to validate schedule action types: %v", es)
+	}
+}
+
+func TestValidateScheduleRecurringDay(t *testing.T) {
+	x := []StringValidationTestCase{
+		// No errors
+		{TestName: "sunday", Value: "su"},
+		{TestName: "monday", Value: "mo"},
+		{TestName: "saturday", Value: "sa"},
+
+		// With errors
+		{TestName: "empty", Value: "", ExpectError: true},
+		{TestName: "upper case", Value: "MO", ExpectError: true},
+		{TestName: "unexpected", Value: "monday", ExpectError: true},
+	}
+
+	es := testStringValidationCases(x, validateScheduleRecurringDay())
+	if len(es) > 0 {
+		t.Errorf("Failed to validate schedule recurring days: %v", es)
+	}
+}
+
+func TestValidateSharingPortalAccess(t *testing.T) {
+	x := []StringValidationTestCase{
+		// No errors
+		{TestName: "run and use", Value: string(SharingPortalAccessRunAndUse)},
+		{TestName: "use", Value: string(SharingPortalAccessUse)},
+		{TestName: "run and view only", Value: string(SharingPortalAccessRunAndViewOnly)},
+		{TestName: "view only", Value: string(SharingPortalAccessViewOnly)},
+
+		// With errors
+		{TestName: "empty", Value: "", ExpectError: true},
+		{TestName: "unexpected", Value: "Foobar", ExpectError: true},
+	}
+
+	es := testStringValidationCases(x, validateSharingPortalAccess())
+	if len(es) > 0 {
+		t.Errorf("Failed to validate sharing portal access levels: %v", es)
+	}
+}
+
 func TestValidateRoleType(t *testing.T) {
 	x := []StringValidationTestCase{
 		// No errors
@@ -48,6 +105,24 @@ func TestValidateRoleType(t *testing.T) {
 	}
 }
 
+func TestValidateDateTime(t *testing.T) {
+	x := []StringValidationTestCase{
+		// No errors
+		{TestName: "date and time", Value: "2026/10/18 08:30:00"},
+
+		// With errors
+		{TestName: "empty", Value: "", ExpectError: true},
+		{TestName: "date only", Value: "2026/10/18", ExpectError: true},
+		{TestName: "dashes", Value: "2026-10-18 08:30:00", ExpectError: true},
+		{TestName: "utc offset", Value: "2026/10/18 08:30:00 -0000", ExpectError: true},
+	}
+
+	es := testStringValidationCases(x, validateDateTime())
+	if len(es) > 0 {
+		t.Errorf("Failed to validate date and time: %v", es)
+	}
+}
+
 func TestValidateNoSubString(t *testing.T) {
 	x := []StringValidationTestCase{
 		// No errors